@@ -9,5 +9,248 @@ type Avl struct {
 
 // NewAvl creates an empty AVL tree.
 func NewAvl() Avl {
-	return Avl{Tree{rebalance: true}}
+	return NewAvlWith()
+}
+
+// NewAvlUnsync creates an empty AVL tree without lock. It is faster, but only one goroutine can use
+// it at the same time.
+func NewAvlUnsync() Avl {
+	return NewAvlWith(WithLock(false))
+}
+
+// NewAvlWith creates an empty AVL tree with the options of the parameters. The tree uses all
+// options: duplicated items, comparator, lock and capacity.
+func NewAvlWith(opts ...Option) Avl {
+	return Avl{newTree(true, newOptions(opts))}
+}
+
+// NewAvlFromSlice creates a perfectly balanced AVL tree with the items of the slice and the
+// options of the parameters, as the NewAvlWith function. If the slice is sorted, the tree is
+// created in linear time. Otherwise the slice is sorted before. The duplicated items are discarded
+// if the tree doesn't allow them, and the greatest items are discarded if they don't fit in the
+// capacity of the tree.
+func NewAvlFromSlice(items []Item, opts ...Option) Avl {
+	return Avl{newSliceTree(items, true, newOptions(opts))}
+}
+
+// fromRoot creates an AVL tree with the nodes of the root tree and the settings of the avl tree,
+// except the duplicated flag, that is the flag of the parameter.
+func (avl *Avl) fromRoot(root *treeNode, duplicated bool) *Avl {
+	result := &Avl{avl.emptyCopy()}
+	result.root, result.length, result.duplicated = root, root.getSize(), duplicated
+	return result
+}
+
+// Split moves the items of the avl tree to two new AVL trees and returns them. The first tree
+// contains the items less than the key and the second tree contains the items greater than or
+// equal to the key. The new trees have the settings of the avl tree. The avl tree is empty after
+// the operation.
+func (avl *Avl) Split(key Item) (*Avl, *Avl) {
+	avl.mutex.Lock()
+	defer avl.mutex.Unlock()
+
+	left, right := splitNodes(avl.root, func(it Item) bool {
+		return avl.compare.less(it, key)
+	}, true)
+
+	avl.root, avl.length = nil, 0
+	return avl.fromRoot(left, avl.duplicated), avl.fromRoot(right, avl.duplicated)
+}
+
+// Join moves all items of the other AVL tree to the avl tree. The items of the other tree must be
+// all less or all greater than the items of the avl tree. If the avl tree allows duplicated items,
+// the items in the limit can be equal. The other tree is empty after the operation. Returns a flag
+// indicating if the trees were joined. The trees aren't joined if the items overlap, the other
+// tree allows duplicated items and the avl tree doesn't, or the avl tree doesn't have capacity
+// for the items of the other tree. The items are compared with the comparator of the avl tree.
+func (avl *Avl) Join(other *Avl) bool {
+	if avl == other {
+		return false
+	}
+
+	unlock := lockPair(&avl.mutex, &other.mutex, false)
+	defer unlock()
+
+	length := avl.length + other.length
+	if other.duplicated && !avl.duplicated || avl.capacity > 0 && length > avl.capacity {
+		return false
+	}
+
+	// before checks if the a item can be before of the b item in the tree.
+	before := func(a, b Item) bool {
+		if avl.duplicated {
+			return !avl.compare.less(b, a)
+		}
+
+		return avl.compare.less(a, b)
+	}
+
+	switch {
+	case avl.root == nil || other.root == nil:
+		avl.root = concatNodes(avl.root, other.root, true)
+
+	case before(maxNode(avl.root).item, minNode(other.root).item):
+		avl.root = concatNodes(avl.root, other.root, true)
+
+	case before(maxNode(other.root).item, minNode(avl.root).item):
+		avl.root = concatNodes(other.root, avl.root, true)
+
+	default:
+		return false
+	}
+
+	avl.length += other.length
+	other.root, other.length = nil, 0
+	return true
+}
+
+// mergeRuns walks the items of the sorted slices a and b, grouping the items that are equal using
+// the cmp comparator, and executes the f function with the group of equal items of each slice.
+// One of the groups can be empty.
+func mergeRuns(a, b []Item, cmp Comparator, f func(runA, runB []Item)) {
+	for len(a) > 0 || len(b) > 0 {
+		var it Item
+
+		if len(b) == 0 || (len(a) > 0 && !cmp.less(b[0], a[0])) {
+			it = a[0]
+		} else {
+			it = b[0]
+		}
+
+		na, nb := 0, 0
+		for na < len(a) && cmp.eq(a[na], it) {
+			na++
+		}
+
+		for nb < len(b) && cmp.eq(b[nb], it) {
+			nb++
+		}
+
+		f(a[:na:na], b[:nb:nb])
+		a, b = a[na:], b[nb:]
+	}
+}
+
+// setOperation creates a new AVL tree with the items returned by the f function. The function is
+// executed with the groups of equal items of the avl tree and the other tree, compared with the
+// comparator of the avl tree. The duplicated parameter is the duplicated flag of the new tree. The
+// new tree has the comparator and the lock settings of the avl tree, without capacity limit.
+func (avl *Avl) setOperation(other *Avl, duplicated bool, f func(runA, runB []Item) []Item) *Avl {
+	var items []Item
+
+	unlock := lockPair(&avl.mutex, &other.mutex, true)
+	a, b := sortedItems(avl.root), sortedItems(other.root)
+	unlock()
+
+	mergeRuns(a, b, avl.compare, func(runA, runB []Item) {
+		items = append(items, f(runA, runB)...)
+	})
+
+	result := avl.fromRoot(buildNodes(items), duplicated)
+	result.capacity = 0
+	return result
+}
+
+// Union returns a new AVL tree with the items of the avl tree and the other tree. If the trees
+// allow duplicated items, the new tree contains each item the max number of times that it is in
+// any of the trees. The new tree allows duplicated items if any of the trees allows them.
+func (avl *Avl) Union(other *Avl) *Avl {
+	duplicated := avl.duplicated || other.duplicated
+
+	return avl.setOperation(other, duplicated, func(a, b []Item) []Item {
+		if len(a) >= len(b) {
+			return a
+		}
+
+		return append(a, b[len(a):]...)
+	})
+}
+
+// Intersection returns a new AVL tree with the items of the avl tree that also are in the other
+// tree. If the trees allow duplicated items, the new tree contains each item the min number of
+// times that it is in the trees.
+func (avl *Avl) Intersection(other *Avl) *Avl {
+	return avl.setOperation(other, avl.duplicated, func(a, b []Item) []Item {
+		if len(a) <= len(b) {
+			return a
+		}
+
+		return a[:len(b)]
+	})
+}
+
+// Difference returns a new AVL tree with the items of the avl tree that aren't in the other tree.
+// If the trees allow duplicated items, each item of the other tree removes only one equal item.
+func (avl *Avl) Difference(other *Avl) *Avl {
+	return avl.setOperation(other, avl.duplicated, func(a, b []Item) []Item {
+		if len(a) <= len(b) {
+			return nil
+		}
+
+		return a[len(b):]
+	})
+}
+
+// SymmetricDifference returns a new AVL tree with the items that are only in one of the trees.
+// If the trees allow duplicated items, the new tree contains each item the difference between the
+// number of times that it is in each tree. The new tree allows duplicated items if any of the
+// trees allows them.
+func (avl *Avl) SymmetricDifference(other *Avl) *Avl {
+	duplicated := avl.duplicated || other.duplicated
+
+	return avl.setOperation(other, duplicated, func(a, b []Item) []Item {
+		if len(a) >= len(b) {
+			return a[len(b):]
+		}
+
+		return b[len(a):]
+	})
+}
+
+// compareRuns executes the f function with the groups of equal items of the avl tree and the
+// other tree, and returns false if f returns false with any group.
+func (avl *Avl) compareRuns(other *Avl, f func(countA, countB int) bool) bool {
+	result := true
+
+	unlock := lockPair(&avl.mutex, &other.mutex, true)
+	a, b := sortedItems(avl.root), sortedItems(other.root)
+	unlock()
+
+	mergeRuns(a, b, avl.compare, func(runA, runB []Item) {
+		result = result && f(len(runA), len(runB))
+	})
+
+	return result
+}
+
+// IsSubset checks if all items of the avl tree are in the other tree. If the trees allow
+// duplicated items, each item must be in the other tree at least the same number of times.
+func (avl *Avl) IsSubset(other *Avl) bool {
+	return avl.compareRuns(other, func(countA, countB int) bool {
+		return countA <= countB
+	})
+}
+
+// Equal checks if the avl tree and the other tree contain the same items, the same number of
+// times.
+func (avl *Avl) Equal(other *Avl) bool {
+	return avl.compareRuns(other, func(countA, countB int) bool {
+		return countA == countB
+	})
+}
+
+// Snapshot returns a persistent AVL tree with the items and the settings of the avl tree. The
+// function copies all nodes of the avl tree, so it is O(n) in time and memory. The avl tree is
+// locked for reading while the nodes are copied, so the operations that modify it wait until the
+// copy ends. The persistent tree can be read later without locks.
+func (avl *Avl) Snapshot() PersistentAvl {
+	avl.mutex.RLock()
+	defer avl.mutex.RUnlock()
+
+	return PersistentAvl{
+		root:       copyTree(avl.root),
+		duplicated: avl.duplicated,
+		compare:    avl.compare,
+		capacity:   avl.capacity,
+	}
 }
@@ -1,6 +1,7 @@
 package mygostructs
 
 import (
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -13,3 +14,367 @@ func Test_NewAvl_func(t *testing.T) {
 	assert.True(t, avl.rebalance, "avl tree must be rebalance")
 	assert.False(t, avl.duplicated, "avl tree duplicated flag is incorrect")
 }
+
+func Test_NewAvlWith_func(t *testing.T) {
+	as := assert.New(t)
+	avl := NewAvlWith(WithComparator(reverse), WithCapacity(5))
+
+	as.True(avl.rebalance, "avl tree must be rebalance")
+	as.False(avl.mutex.disabled, "avl tree lock is disabled")
+
+	for num := 0; num < 10; num++ {
+		as.Equal(avl.Insert(It(num)), num < 5, "insert of %d is incorrect", num)
+	}
+
+	checkAvl(t, &avl, []int{4, 3, 2, 1, 0})
+
+	item, _ := avl.Min()
+	as.Equal(item, It(4), "min is incorrect")
+	item, _ = avl.Ceiling(It(7))
+	as.Equal(item, It(4), "ceiling is incorrect")
+	as.Equal(avl.Rank(It(2)), 2, "rank is incorrect")
+	as.Equal(avl.CountRange(Bounds{It(3), It(1), true, false}), 2, "count range is incorrect")
+
+	_, found := avl.Search(It(2))
+	as.True(found, "item not found")
+
+	// the trees created by the avl tree use its comparator.
+	left, right := avl.Split(It(2))
+	checkAvl(t, left, []int{4, 3})
+	checkAvl(t, right, []int{2, 1, 0})
+	as.True(left.Join(right), "trees weren't joined")
+	checkAvl(t, left, []int{4, 3, 2, 1, 0})
+
+	other := NewAvlWith(WithComparator(reverse))
+	other.Insert(It(6))
+	other.Insert(It(5))
+
+	// the result of the set operations doesn't have capacity limit.
+	left.Delete(It(0))
+	union := left.Union(&other)
+	as.True(union.Insert(It(10)), "item wasn't inserted")
+	checkAvl(t, union, []int{10, 6, 5, 4, 3, 2, 1})
+
+	checkPersistentAvl(t, avl.Snapshot(), []int{})
+	checkPersistentAvl(t, left.Snapshot(), []int{4, 3, 2, 1})
+
+	// duplicated items
+	avl = NewAvlWith(WithDuplicates(true))
+	as.True(avl.Insert(It(1)), "item wasn't inserted")
+	as.True(avl.Insert(It(1)), "duplicated item wasn't inserted")
+	checkAvl(t, &avl, []int{1, 1})
+}
+
+// newAvlRange creates an AVL tree with the numbers from min to max - 1.
+func newAvlRange(min, max int, duplicated bool) *Avl {
+	avl := NewAvl()
+	avl.duplicated = duplicated
+
+	for num := min; num < max; num++ {
+		avl.Insert(It(num))
+	}
+
+	return &avl
+}
+
+// checkAvl checks the items, the length, the heights and the sizes of the avl tree.
+func checkAvl(t *testing.T, avl *Avl, values []int) {
+	assert.Equal(t, treeValues(&avl.Tree), values, "items don't match")
+	assert.Equal(t, avl.length, len(values), "length doesn't match")
+	checkHeights(t, avl.root)
+	checkSizes(t, avl.root)
+}
+
+// intRange returns a slice with the numbers from min to max - 1.
+func intRange(min, max int) []int {
+	values := []int{}
+	for num := min; num < max; num++ {
+		values = append(values, num)
+	}
+
+	return values
+}
+
+func Test_Avl_Split_func(t *testing.T) {
+	as := assert.New(t)
+
+	for key := -1; key <= 101; key++ {
+		avl := newAvlRange(0, 100, false)
+		left, right := avl.Split(It(key))
+
+		// position of the key in the tree.
+		pos := key
+		if pos < 0 {
+			pos = 0
+		} else if pos > 100 {
+			pos = 100
+		}
+
+		checkAvl(t, avl, []int{})
+		checkAvl(t, left, intRange(0, pos))
+		checkAvl(t, right, intRange(pos, 100))
+		as.True(left.rebalance, "left tree isn't rebalanced")
+		as.True(right.rebalance, "right tree isn't rebalanced")
+	}
+
+	// duplicated items
+	avl := newAvlRange(0, 10, true)
+	avl.Insert(It(5))
+	left, right := avl.Split(It(5))
+
+	checkAvl(t, left, []int{0, 1, 2, 3, 4})
+	checkAvl(t, right, []int{5, 5, 6, 7, 8, 9})
+	as.True(left.duplicated, "left tree doesn't allow duplicated items")
+	as.True(right.duplicated, "right tree doesn't allow duplicated items")
+}
+
+func Test_Avl_Join_func(t *testing.T) {
+	as := assert.New(t)
+
+	for size := 0; size <= 50; size++ {
+		// other tree greater than avl tree.
+		avl := newAvlRange(0, size, false)
+		other := newAvlRange(size, 50, false)
+		as.True(avl.Join(other), "trees weren't joined")
+		checkAvl(t, avl, intRange(0, 50))
+		checkAvl(t, other, []int{})
+
+		// other tree less than avl tree.
+		avl = newAvlRange(size, 50, false)
+		other = newAvlRange(0, size, false)
+		as.True(avl.Join(other), "trees weren't joined")
+		checkAvl(t, avl, intRange(0, 50))
+		checkAvl(t, other, []int{})
+	}
+
+	// overlap
+	avl := newAvlRange(0, 10, false)
+	other := newAvlRange(5, 15, false)
+	as.False(avl.Join(other), "trees were joined")
+	checkAvl(t, avl, intRange(0, 10))
+	checkAvl(t, other, intRange(5, 15))
+
+	// same items in the limit
+	other = newAvlRange(9, 15, false)
+	as.False(avl.Join(other), "trees were joined with duplicated items")
+
+	avl = newAvlRange(0, 10, true)
+	other = newAvlRange(9, 15, true)
+	as.True(avl.Join(other), "trees weren't joined")
+	checkAvl(t, avl, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 9, 10, 11, 12, 13, 14})
+
+	// duplicated flag
+	avl = newAvlRange(0, 10, false)
+	other = newAvlRange(10, 15, true)
+	as.False(avl.Join(other), "tree with duplicated items was joined")
+
+	// same tree
+	as.False(avl.Join(avl), "tree was joined with itself")
+}
+
+func Test_Avl_Join_func_sync(t *testing.T) {
+	as := assert.New(t)
+	a := newAvlRange(0, 10, true)
+	b := newAvlRange(10, 20, true)
+	size := 1000
+	done := make(chan bool)
+
+	// join the trees in both directions at the same time.
+	join := func(avl, other *Avl) {
+		for i := 0; i < size; i++ {
+			avl.Join(other)
+		}
+
+		done <- true
+	}
+
+	go join(a, b)
+	go join(b, a)
+	<-done
+	<-done
+
+	as.Equal(a.Length()+b.Length(), 20, "number of items doesn't match")
+}
+
+// newAvlValues creates an AVL tree with the values of the parameter.
+func newAvlValues(values []int, duplicated bool) *Avl {
+	avl := NewAvl()
+	avl.duplicated = duplicated
+
+	for _, value := range values {
+		avl.Insert(It(value))
+	}
+
+	return &avl
+}
+
+func Test_Avl_set_operations(t *testing.T) {
+	as := assert.New(t)
+
+	operations := []struct {
+		name string
+		f    func(a, b *Avl) *Avl
+	}{
+		{"union", (*Avl).Union},
+		{"intersection", (*Avl).Intersection},
+		{"difference", (*Avl).Difference},
+		{"symmetric difference", (*Avl).SymmetricDifference},
+	}
+
+	params := []struct {
+		a, b                   []int
+		aDuplicated            bool
+		bDuplicated            bool
+		union, intersection    []int
+		difference, symmetric  []int
+		unionDup, symmetricDup bool
+	}{
+		{
+			[]int{1, 3, 5, 7, 9}, []int{3, 4, 5, 6}, false, false,
+			[]int{1, 3, 4, 5, 6, 7, 9}, []int{3, 5},
+			[]int{1, 7, 9}, []int{1, 4, 6, 7, 9},
+			false, false,
+		},
+		{
+			[]int{}, []int{1, 2}, false, false,
+			[]int{1, 2}, []int{},
+			[]int{}, []int{1, 2},
+			false, false,
+		},
+		{
+			[]int{1, 1, 1, 2, 3, 3}, []int{1, 2, 2, 4}, true, true,
+			[]int{1, 1, 1, 2, 2, 3, 3, 4}, []int{1, 2},
+			[]int{1, 1, 3, 3}, []int{1, 1, 2, 3, 3, 4},
+			true, true,
+		},
+		{
+			[]int{1, 2, 3}, []int{2, 2, 4}, false, true,
+			[]int{1, 2, 2, 3, 4}, []int{2},
+			[]int{1, 3}, []int{1, 2, 3, 4},
+			true, true,
+		},
+	}
+
+	for _, param := range params {
+		a := newAvlValues(param.a, param.aDuplicated)
+		b := newAvlValues(param.b, param.bDuplicated)
+		results := [][]int{
+			param.union,
+			param.intersection,
+			param.difference,
+			param.symmetric,
+		}
+		duplicated := []bool{
+			param.unionDup,
+			param.aDuplicated,
+			param.aDuplicated,
+			param.symmetricDup,
+		}
+
+		for indx, operation := range operations {
+			result := operation.f(a, b)
+			msg := fmt.Sprintf("%s of %v and %v", operation.name, param.a, param.b)
+
+			as.Equal(treeValues(&result.Tree), results[indx], "%s: invalid items", msg)
+			as.Equal(result.Length(), len(results[indx]), "%s: invalid length", msg)
+			as.True(result.rebalance, "%s: not rebalanced", msg)
+			as.Equal(result.duplicated, duplicated[indx], "%s: invalid flag", msg)
+			checkHeights(t, result.root)
+			checkSizes(t, result.root)
+		}
+
+		// the trees don't change.
+		as.Equal(treeValues(&a.Tree), param.a, "items of the first tree changed")
+		as.Equal(treeValues(&b.Tree), param.b, "items of the second tree changed")
+	}
+
+	// operations with the same tree
+	a := newAvlValues([]int{1, 2, 2}, true)
+	as.Equal(treeValues(&a.Union(a).Tree), []int{1, 2, 2}, "union with itself is invalid")
+	as.Equal(treeValues(&a.Difference(a).Tree), []int{}, "difference with itself is invalid")
+}
+
+func Test_Avl_IsSubset_func(t *testing.T) {
+	params := []struct {
+		a, b       []int
+		duplicated bool
+		result     bool
+	}{
+		{[]int{}, []int{1}, false, true},
+		{[]int{1, 3}, []int{1, 2, 3}, false, true},
+		{[]int{1, 2}, []int{1, 2}, false, true},
+		{[]int{1, 4}, []int{1, 2, 3}, false, false},
+		{[]int{1, 1}, []int{1, 2}, true, false},
+		{[]int{1, 1}, []int{1, 1, 1}, true, true},
+	}
+
+	for _, param := range params {
+		a := newAvlValues(param.a, param.duplicated)
+		b := newAvlValues(param.b, param.duplicated)
+		assert.Equal(t, a.IsSubset(b), param.result, "%v subset of %v", param.a, param.b)
+	}
+}
+
+func Test_Avl_Equal_func(t *testing.T) {
+	params := []struct {
+		a, b       []int
+		duplicated bool
+		result     bool
+	}{
+		{[]int{}, []int{}, false, true},
+		{[]int{1, 2, 3}, []int{3, 2, 1}, false, true},
+		{[]int{1, 2}, []int{1, 2, 3}, false, false},
+		{[]int{1, 2, 4}, []int{1, 2, 3}, false, false},
+		{[]int{1, 1}, []int{1}, true, false},
+		{[]int{1, 1}, []int{1, 1}, true, true},
+	}
+
+	for _, param := range params {
+		a := newAvlValues(param.a, param.duplicated)
+		b := newAvlValues(param.b, param.duplicated)
+		assert.Equal(t, a.Equal(b), param.result, "%v equal to %v", param.a, param.b)
+	}
+}
+
+func Test_NewAvlFromSlice_func(t *testing.T) {
+	as := assert.New(t)
+
+	avl := NewAvlFromSlice([]Item{It(5), It(3), It(8), It(3), It(1)})
+	checkAvl(t, &avl, []int{1, 3, 5, 8})
+	as.True(avl.rebalance, "avl tree must be rebalance")
+	as.False(avl.duplicated, "avl tree duplicated flag is incorrect")
+
+	// the tree works as an AVL tree.
+	for num := 10; num < 100; num++ {
+		avl.Insert(It(num))
+	}
+	checkAvl(t, &avl, append([]int{1, 3, 5, 8}, intRange(10, 100)...))
+
+	avl = NewAvlFromSlice(nil)
+	checkAvl(t, &avl, []int{})
+
+	// the tree uses the options.
+	avl = NewAvlFromSlice([]Item{It(5), It(3), It(8), It(3), It(1)},
+		WithDuplicates(true), WithComparator(reverse), WithLock(false))
+	checkAvl(t, &avl, []int{8, 5, 3, 3, 1})
+	as.True(avl.duplicated, "avl tree duplicated flag is incorrect")
+	as.True(avl.mutex.disabled, "avl tree lock isn't disabled")
+
+	avl = NewAvlFromSlice([]Item{It(5), It(3), It(8), It(1)}, WithCapacity(2))
+	checkAvl(t, &avl, []int{1, 3})
+	as.False(avl.Insert(It(0)), "item was inserted in full tree")
+}
+
+func Test_Avl_Snapshot_func(t *testing.T) {
+	as := assert.New(t)
+	avl := newAvlRange(0, 50, false)
+	snapshot := avl.Snapshot()
+
+	for num := 0; num < 50; num += 2 {
+		avl.Delete(It(num))
+	}
+
+	checkPersistentAvl(t, snapshot, intRange(0, 50))
+	as.Equal(sharedNodes(snapshot.root, avl.root), 0, "the snapshot shares nodes")
+	as.False(snapshot.duplicated, "snapshot duplicated flag is incorrect")
+}
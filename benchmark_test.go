@@ -1,6 +1,9 @@
 package mygostructs
 
-import "testing"
+import (
+	"math/rand"
+	"testing"
+)
 
 // List benchmarks
 // ---------------
@@ -49,3 +52,277 @@ func searchInListNElem(num int, b *testing.B) *List {
 func Benchmark_ListSearch10000000(b *testing.B) {
 	searchInListNElem(10000000, b)
 }
+
+// Bst benchmarks
+// --------------
+func bstInsertSorted(num int, b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		bst := NewBst()
+
+		for j := 0; j < num; j++ {
+			bst.Insert(It(j))
+		}
+	}
+}
+
+func Benchmark_BstInsertSorted1000(b *testing.B) {
+	bstInsertSorted(1000, b)
+}
+
+func Benchmark_BstInsertSorted5000(b *testing.B) {
+	bstInsertSorted(5000, b)
+}
+
+// Avl and BTree benchmarks
+// ------------------------
+func avlInsert(num int, b *testing.B, newAvl func() Avl) {
+	for i := 0; i < b.N; i++ {
+		avl := newAvl()
+
+		for j := 0; j < num; j++ {
+			avl.Insert(It(j * 7919 % num))
+		}
+	}
+}
+
+func bTreeInsert(num int, b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		bt := NewBTree(32)
+
+		for j := 0; j < num; j++ {
+			bt.Insert(It(j * 7919 % num))
+		}
+	}
+}
+
+func Benchmark_AvlInsert100000(b *testing.B) {
+	avlInsert(100000, b, NewAvl)
+}
+
+func Benchmark_BTreeInsert100000(b *testing.B) {
+	bTreeInsert(100000, b)
+}
+
+// SkipList benchmarks
+// -------------------
+func parallelSearch(num int, b *testing.B, insert func(Item), search func(Item)) {
+	for i := 0; i < num; i++ {
+		insert(It(i * 7919 % num))
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			search(It(i % num))
+		}
+	})
+}
+
+func Benchmark_AvlSearchParallel100000(b *testing.B) {
+	avl := NewAvl()
+	insert := func(it Item) { avl.Insert(it) }
+	parallelSearch(100000, b, insert, func(it Item) { avl.Search(it) })
+}
+
+func Benchmark_SkipListSearchParallel100000(b *testing.B) {
+	sl := NewSkipListSeed(1)
+	insert := func(it Item) { sl.Insert(it) }
+	parallelSearch(100000, b, insert, func(it Item) { sl.Search(it) })
+}
+
+// List positional benchmarks
+// --------------------------
+func Benchmark_ListAt100000(b *testing.B) {
+	list := NewList(false)
+	for i := 0; i < 100000; i++ {
+		list.AddAfter(It(i))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.At(i * 7919 % 100000)
+	}
+}
+
+func Benchmark_ListIndexOf100000(b *testing.B) {
+	list := NewList(false)
+	for i := 0; i < 100000; i++ {
+		list.AddAfter(It(i))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.IndexOf(It(i * 7919 % 100000))
+	}
+}
+
+func Benchmark_ListSort100000(b *testing.B) {
+	list := NewList(false)
+	for i := 0; i < 100000; i++ {
+		list.AddAfter(It(i))
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		list.Shuffle(rnd)
+		b.StartTimer()
+
+		list.Sort(nil)
+	}
+}
+
+func Benchmark_ListConcat1000(b *testing.B) {
+	list := NewList(false)
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		batch := NewList(false)
+		for j := 0; j < 1000; j++ {
+			batch.AddAfter(It(i*1000 + j))
+		}
+		b.StartTimer()
+
+		list.Concat(&batch)
+	}
+}
+
+// Compare benchmarks
+// ------------------
+// The IntItem items implement the Comparer interface and the testItem items don't implement it,
+// so the structs compare them calling the Less and Eq functions.
+func avlSearch(num int, b *testing.B, item func(int) Item) {
+	avl := NewAvl()
+	for i := 0; i < num; i++ {
+		avl.Insert(item(i * 7919 % num))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		avl.Search(item(i % num))
+	}
+}
+
+func avlInsertItems(num int, b *testing.B, item func(int) Item) {
+	for i := 0; i < b.N; i++ {
+		avl := NewAvl()
+
+		for j := 0; j < num; j++ {
+			avl.Insert(item(j * 7919 % num))
+		}
+	}
+}
+
+func listSearch(num int, b *testing.B, item func(int) Item) {
+	list := NewList(false)
+	for i := 0; i < num; i++ {
+		list.AddAfter(item(i * 7919 % num))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Search(item(i % num))
+	}
+}
+
+func intItem(num int) Item {
+	return It(num)
+}
+
+func lessEqItem(num int) Item {
+	return testItem{num}
+}
+
+func Benchmark_AvlCompareInsert100000(b *testing.B) {
+	avlInsertItems(100000, b, intItem)
+}
+
+func Benchmark_AvlLessEqInsert100000(b *testing.B) {
+	avlInsertItems(100000, b, lessEqItem)
+}
+
+func Benchmark_AvlCompareSearch1000(b *testing.B) {
+	avlSearch(1000, b, intItem)
+}
+
+func Benchmark_AvlLessEqSearch1000(b *testing.B) {
+	avlSearch(1000, b, lessEqItem)
+}
+
+func Benchmark_ListCompareSearch1000(b *testing.B) {
+	listSearch(1000, b, intItem)
+}
+
+func Benchmark_ListLessEqSearch1000(b *testing.B) {
+	listSearch(1000, b, lessEqItem)
+}
+
+// Unsync benchmarks
+// -----------------
+func listAddAfter(num int, b *testing.B, newList func(bool) List) {
+	for i := 0; i < b.N; i++ {
+		list := newList(false)
+
+		for j := 0; j < num; j++ {
+			list.AddAfter(It(j))
+		}
+	}
+}
+
+func queueEnqueueDequeue(num int, b *testing.B, queue *Queue) {
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < num; j++ {
+			queue.Enqueue(It(j))
+		}
+
+		for j := 0; j < num; j++ {
+			queue.Dequeue()
+		}
+	}
+}
+
+func stackPushPop(num int, b *testing.B, stack *Stack) {
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < num; j++ {
+			stack.Push(It(j))
+		}
+
+		for j := 0; j < num; j++ {
+			stack.Pop()
+		}
+	}
+}
+
+func Benchmark_AvlUnsyncInsert100000(b *testing.B) {
+	avlInsert(100000, b, NewAvlUnsync)
+}
+
+func Benchmark_ListAddAfter100000(b *testing.B) {
+	listAddAfter(100000, b, NewList)
+}
+
+func Benchmark_ListUnsyncAddAfter100000(b *testing.B) {
+	listAddAfter(100000, b, NewListUnsync)
+}
+
+func Benchmark_QueueEnqueueDequeue100000(b *testing.B) {
+	queue := NewQueue()
+	queueEnqueueDequeue(100000, b, &queue)
+}
+
+func Benchmark_QueueUnsyncEnqueueDequeue100000(b *testing.B) {
+	queue := NewQueueUnsync()
+	queueEnqueueDequeue(100000, b, &queue)
+}
+
+func Benchmark_StackPushPop100000(b *testing.B) {
+	stack := NewStack()
+	stackPushPop(100000, b, &stack)
+}
+
+func Benchmark_StackUnsyncPushPop100000(b *testing.B) {
+	stack := NewStackUnsync()
+	stackPushPop(100000, b, &stack)
+}
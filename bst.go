@@ -9,5 +9,25 @@ type Bst struct {
 
 // NewBst returns an empty Bst.
 func NewBst() Bst {
-	return Bst{Tree{rebalance: false}}
+	return NewBstWith()
+}
+
+// NewBstUnsync returns an empty Bst without lock, for using it in only one goroutine.
+func NewBstUnsync() Bst {
+	return NewBstWith(WithLock(false))
+}
+
+// NewBstWith returns an empty Bst with the options of the parameters. The tree uses all options:
+// duplicated items, comparator, lock and capacity.
+func NewBstWith(opts ...Option) Bst {
+	return Bst{newTree(false, newOptions(opts))}
+}
+
+// NewBstFromSlice creates a perfectly balanced binary search tree with the items of the slice and
+// the options of the parameters, as the NewBstWith function. If the slice is sorted, the tree is
+// created in linear time. Otherwise the slice is sorted before. The duplicated items are discarded
+// if the tree doesn't allow them, and the greatest items are discarded if they don't fit in the
+// capacity of the tree.
+func NewBstFromSlice(items []Item, opts ...Option) Bst {
+	return Bst{newSliceTree(items, false, newOptions(opts))}
 }
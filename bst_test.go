@@ -13,3 +13,79 @@ func Test_NewBst_func(t *testing.T) {
 	assert.False(t, bst.rebalance, "bst mustn't be rebalanced")
 	assert.False(t, bst.duplicated, "bst duplicated flag is incorrect")
 }
+
+func Test_NewBstWith_func(t *testing.T) {
+	as := assert.New(t)
+	bst := NewBstWith(WithDuplicates(true), WithComparator(lastDigit), WithLock(false))
+
+	as.False(bst.rebalance, "bst mustn't be rebalanced")
+	as.True(bst.mutex.disabled, "bst lock isn't disabled")
+
+	for _, num := range []int{3, 13, 1} {
+		as.True(bst.Insert(It(num)), "item %d wasn't inserted", num)
+	}
+
+	as.Equal(treeValues(&bst.Tree), []int{1, 3, 13}, "items don't match")
+
+	item, _ := bst.Search(It(21))
+	as.Equal(item, It(1), "item found is incorrect")
+}
+
+func Test_NewBstFromSlice_func(t *testing.T) {
+	as := assert.New(t)
+	items := []Item{}
+
+	for num := 0; num < 7; num++ {
+		items = append(items, It(num))
+	}
+
+	bst := NewBstFromSlice(items)
+	checkTree(t, bst.root, []treeTest{
+		{0, 0, nil, nil},
+		{1, 1, i(0), i(2)},
+		{2, 0, nil, nil},
+		{3, 2, i(1), i(5)},
+		{4, 0, nil, nil},
+		{5, 1, i(4), i(6)},
+		{6, 0, nil, nil},
+	})
+
+	as.Equal(bst.length, 7, "bst length is incorrect")
+	as.False(bst.rebalance, "bst mustn't be rebalanced")
+	as.False(bst.duplicated, "bst duplicated flag is incorrect")
+
+	// the tree uses the options.
+	bst = NewBstFromSlice([]Item{It(13), It(2), It(3), It(22)},
+		WithComparator(lastDigit), WithCapacity(2))
+	as.Equal(bst.length, 2, "bst length is incorrect")
+	as.Equal(bst.capacity, 2, "bst capacity is incorrect")
+
+	item, _ := bst.Min()
+	as.Equal(item, It(2), "first item isn't kept")
+	item, _ = bst.Max()
+	as.Equal(item, It(13), "items aren't sorted with the comparator")
+}
+
+func Test_Bst_degenerate(t *testing.T) {
+	as := assert.New(t)
+	bst := NewBst()
+	size := 3000
+
+	// the tree is a linked list.
+	for num := 0; num < size; num++ {
+		as.True(bst.Insert(It(num)), "item %d wasn't inserted", num)
+	}
+
+	it, found := bst.Search(It(size - 1))
+	as.True(found, "last item not found")
+	as.Equal(it.(IntItem).value, size-1, "item found is incorrect")
+	checkSizes(t, bst.root)
+
+	for num := size - 1; num >= 0; num-- {
+		_, deleted := bst.Delete(It(num))
+		as.True(deleted, "item %d wasn't deleted", num)
+	}
+
+	as.Nil(bst.root, "bst root isn't nil")
+	as.Equal(bst.length, 0, "bst length is incorrect")
+}
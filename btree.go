@@ -0,0 +1,437 @@
+package mygostructs
+
+// bTreeNode is the internal node of the B-tree. The items of the children[i] node are less than
+// or equal to items[i], and the items of the children[i+1] node are greater than or equal to
+// items[i]. The leaf nodes don't have children.
+type bTreeNode struct {
+	items    []Item
+	children []*bTreeNode
+}
+
+// leaf checks if the node is a leaf.
+func (node *bTreeNode) leaf() bool {
+	return len(node.children) == 0
+}
+
+// lowerBound returns the position of the first item of the node that isn't less than the it item,
+// comparing the items with the cmp comparator.
+func (node *bTreeNode) lowerBound(it Item, cmp Comparator) int {
+	low, high := 0, len(node.items)
+
+	for low < high {
+		mid := (low + high) / 2
+		if cmp.less(node.items[mid], it) {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	return low
+}
+
+// upperBound returns the position of the first item of the node that is greater than the it item,
+// comparing the items with the cmp comparator.
+func (node *bTreeNode) upperBound(it Item, cmp Comparator) int {
+	low, high := 0, len(node.items)
+
+	for low < high {
+		mid := (low + high) / 2
+		if cmp.less(it, node.items[mid]) {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	return low
+}
+
+// insertItemAt inserts the it item in the position i of the items.
+func (node *bTreeNode) insertItemAt(i int, it Item) {
+	node.items = append(node.items, nil)
+	copy(node.items[i+1:], node.items[i:])
+	node.items[i] = it
+}
+
+// removeItemAt removes the item in the position i of the items and returns it.
+func (node *bTreeNode) removeItemAt(i int) Item {
+	it := node.items[i]
+	copy(node.items[i:], node.items[i+1:])
+	node.items[len(node.items)-1] = nil
+	node.items = node.items[:len(node.items)-1]
+	return it
+}
+
+// insertChildAt inserts the child node in the position i of the children.
+func (node *bTreeNode) insertChildAt(i int, child *bTreeNode) {
+	node.children = append(node.children, nil)
+	copy(node.children[i+1:], node.children[i:])
+	node.children[i] = child
+}
+
+// removeChildAt removes the child in the position i of the children and returns it.
+func (node *bTreeNode) removeChildAt(i int) *bTreeNode {
+	child := node.children[i]
+	copy(node.children[i:], node.children[i+1:])
+	node.children[len(node.children)-1] = nil
+	node.children = node.children[:len(node.children)-1]
+	return child
+}
+
+// splitChild splits the full child in the position i in two nodes with degree - 1 items. The
+// middle item of the child is moved to the node.
+func (node *bTreeNode) splitChild(i, degree int) {
+	child := node.children[i]
+	right := &bTreeNode{items: make([]Item, degree-1, 2*degree-1)}
+	copy(right.items, child.items[degree:])
+
+	if !child.leaf() {
+		right.children = make([]*bTreeNode, degree, 2*degree)
+		copy(right.children, child.children[degree:])
+
+		for j := degree; j < len(child.children); j++ {
+			child.children[j] = nil
+		}
+		child.children = child.children[:degree]
+	}
+
+	mid := child.items[degree-1]
+	for j := degree - 1; j < len(child.items); j++ {
+		child.items[j] = nil
+	}
+	child.items = child.items[:degree-1]
+
+	node.insertItemAt(i, mid)
+	node.insertChildAt(i+1, right)
+}
+
+// mergeChildren merges the children in the positions i and i + 1 and the item in the position i
+// in the child in the position i.
+func (node *bTreeNode) mergeChildren(i int) {
+	left := node.children[i]
+	right := node.removeChildAt(i + 1)
+
+	left.items = append(left.items, node.removeItemAt(i))
+	left.items = append(left.items, right.items...)
+	left.children = append(left.children, right.children...)
+}
+
+// growChild ensures the child in the position i has at least degree items, so an item can be
+// deleted from it. It moves an item from a sibling, or merges the child with a sibling. Returns
+// the new position of the child.
+func (node *bTreeNode) growChild(i, degree int) int {
+	child := node.children[i]
+
+	if len(child.items) >= degree {
+		return i
+	}
+
+	if i > 0 && len(node.children[i-1].items) >= degree {
+		// move an item from the left sibling
+		left := node.children[i-1]
+		child.insertItemAt(0, node.items[i-1])
+		node.items[i-1] = left.removeItemAt(len(left.items) - 1)
+
+		if !left.leaf() {
+			child.insertChildAt(0, left.removeChildAt(len(left.children)-1))
+		}
+
+		return i
+	}
+
+	if i < len(node.children)-1 && len(node.children[i+1].items) >= degree {
+		// move an item from the right sibling
+		right := node.children[i+1]
+		child.items = append(child.items, node.items[i])
+		node.items[i] = right.removeItemAt(0)
+
+		if !right.leaf() {
+			child.children = append(child.children, right.removeChildAt(0))
+		}
+
+		return i
+	}
+
+	if i < len(node.children)-1 {
+		node.mergeChildren(i)
+		return i
+	}
+
+	node.mergeChildren(i - 1)
+	return i - 1
+}
+
+// removeMin removes the smallest item of the node tree and returns it. The node must have at least
+// degree items, or be the root.
+func (node *bTreeNode) removeMin(degree int) Item {
+	for !node.leaf() {
+		node = node.children[node.growChild(0, degree)]
+	}
+
+	return node.removeItemAt(0)
+}
+
+// removeMax removes the greatest item of the node tree and returns it. The node must have at least
+// degree items, or be the root.
+func (node *bTreeNode) removeMax(degree int) Item {
+	for !node.leaf() {
+		node = node.children[node.growChild(len(node.children)-1, degree)]
+	}
+
+	return node.removeItemAt(len(node.items) - 1)
+}
+
+// walkBTree visits sorted the items of the node tree that are inside of the b range and executes
+// the visit function with each item. The items are compared with the cmp comparator. The walk
+// stops when the visit function returns false. The function returns false if the walk was stopped.
+func walkBTree(node *bTreeNode, b Bounds, cmp Comparator, visit func(Item) bool) bool {
+	start := 0
+	if b.From != nil {
+		start = node.lowerBound(b.From, cmp)
+	}
+
+	for i := start; i <= len(node.items); i++ {
+		if !node.leaf() && !walkBTree(node.children[i], b, cmp, visit) {
+			return false
+		}
+
+		if i == len(node.items) {
+			break
+		}
+
+		if !b.beforeTo(node.items[i], cmp) {
+			return false
+		}
+
+		if b.afterFrom(node.items[i], cmp) && !visit(node.items[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// BTree is a struct it implements a B-tree type data structure. Each node of the tree stores
+// several items, so the tree has less nodes and is less deep than the binary trees. It uses less
+// memory and it is faster with a big number of items.
+//
+// The degree of the tree defines the number of items of the nodes. All nodes, except the root,
+// store between degree - 1 and 2 * degree - 1 items.
+//
+// The struct is adapted to run in multithread code.
+type BTree struct {
+	root       *bTreeNode // Tree root.
+	length     int        // Number of items in the tree.
+	degree     int        // Min number of children of the nodes.
+	duplicated bool       // Flag indicating if allows duplicated items.
+	compare    Comparator // Function for comparing the items. If nil, Less and Eq are used.
+	capacity   int        // Max number of items. If it is 0, the number isn't limited.
+	mutex      rwLock     // Lock for avoid the concurrence when manipulate the struct.
+}
+
+// NewBTree creates an empty B-tree with the degree of the parameter. The degree must be 2 or
+// greater. If it is less, 2 is used.
+func NewBTree(degree int) BTree {
+	return NewBTreeWith(degree)
+}
+
+// NewBTreeUnsync creates an empty B-tree without lock, for using it in only one goroutine. The
+// degree works as in the NewBTree function.
+func NewBTreeUnsync(degree int) BTree {
+	return NewBTreeWith(degree, WithLock(false))
+}
+
+// NewBTreeWith creates an empty B-tree with the degree and the options of the parameters. The
+// degree works as in the NewBTree function. The tree uses all options: duplicated items,
+// comparator, lock and capacity.
+func NewBTreeWith(degree int, opts ...Option) BTree {
+	o := newOptions(opts)
+	return BTree{
+		degree:     max(degree, 2),
+		duplicated: o.duplicated,
+		compare:    o.compare,
+		capacity:   o.capacity,
+		mutex:      o.lock(),
+	}
+}
+
+// Insert inserts the item in the tree. The function returns a flag indicating if the operation
+// was success or the item cannot be inserted because it was duplicated or the tree is full.
+func (bt *BTree) Insert(it Item) bool {
+	bt.mutex.Lock()
+	defer bt.mutex.Unlock()
+
+	degree := max(bt.degree, 2)
+
+	if isFull(bt.length, bt.capacity) || !bt.duplicated && bt.search(it) != nil {
+		return false
+	}
+
+	if bt.root == nil {
+		bt.root = &bTreeNode{items: make([]Item, 0, 2*degree-1)}
+	}
+
+	if len(bt.root.items) == 2*degree-1 {
+		// the root is full
+		bt.root = &bTreeNode{children: []*bTreeNode{bt.root}}
+		bt.root.splitChild(0, degree)
+	}
+
+	node := bt.root
+	for !node.leaf() {
+		i := node.upperBound(it, bt.compare)
+
+		if len(node.children[i].items) == 2*degree-1 {
+			node.splitChild(i, degree)
+
+			if !bt.compare.less(it, node.items[i]) {
+				i++
+			}
+		}
+
+		node = node.children[i]
+	}
+
+	node.insertItemAt(node.upperBound(it, bt.compare), it)
+	bt.length++
+	return true
+}
+
+// search searchs the item in the tree. Returns the node that contains the item or nil if the item
+// isn't found.
+func (bt *BTree) search(it Item) *bTreeNode {
+	node := bt.root
+
+	for node != nil {
+		i := node.lowerBound(it, bt.compare)
+		if i < len(node.items) && bt.compare.eq(node.items[i], it) {
+			return node
+		}
+
+		if node.leaf() {
+			return nil
+		}
+
+		node = node.children[i]
+	}
+
+	return nil
+}
+
+// Search searchs the item in the tree. It returns the item found and a flag indicating if the
+// item exists in the tree.
+func (bt *BTree) Search(it Item) (Item, bool) {
+	bt.mutex.RLock()
+	defer bt.mutex.RUnlock()
+
+	if node := bt.search(it); node != nil {
+		return node.items[node.lowerBound(it, bt.compare)], true
+	}
+
+	return nil, false
+}
+
+// Delete deletes the item of the tree. Returns the item deleted and a flag indicating if the item
+// existed in the tree.
+func (bt *BTree) Delete(it Item) (Item, bool) {
+	var (
+		itDeleted Item
+		found     bool
+	)
+
+	bt.mutex.Lock()
+	defer bt.mutex.Unlock()
+
+	if bt.search(it) == nil {
+		return nil, false
+	}
+
+	degree := max(bt.degree, 2)
+	node := bt.root
+
+	for !found {
+		i := node.lowerBound(it, bt.compare)
+
+		if i < len(node.items) && bt.compare.eq(node.items[i], it) {
+			found = true
+			itDeleted = node.items[i]
+
+			switch {
+			case node.leaf():
+				node.removeItemAt(i)
+
+			case len(node.children[i].items) >= degree:
+				node.items[i] = node.children[i].removeMax(degree)
+
+			case len(node.children[i+1].items) >= degree:
+				node.items[i] = node.children[i+1].removeMin(degree)
+
+			default:
+				// the item is moved to the merged child and deleted from there.
+				node.mergeChildren(i)
+				node = node.children[i]
+				found = false
+			}
+
+			continue
+		}
+
+		node = node.children[node.growChild(i, degree)]
+	}
+
+	if len(bt.root.items) == 0 {
+		if bt.root.leaf() {
+			bt.root = nil
+		} else {
+			bt.root = bt.root.children[0]
+		}
+	}
+
+	bt.length--
+	return itDeleted, true
+}
+
+// Length returns the number of items in the tree.
+func (bt *BTree) Length() int {
+	bt.mutex.RLock()
+	defer bt.mutex.RUnlock()
+
+	return bt.length
+}
+
+// Clear clears the tree.
+func (bt *BTree) Clear() {
+	bt.mutex.Lock()
+	defer bt.mutex.Unlock()
+
+	bt.root = nil
+	bt.length = 0
+}
+
+// Traverse executes the function of the parameter with the items of the tree, sorted. The
+// traverse stops when the function returns false. It works as the Range function.
+func (bt *BTree) Traverse(f func(Item) bool) {
+	bt.Range(Bounds{}, f)
+}
+
+// Range executes the function of the parameter with the items of the tree that are inside of the
+// b range, sorted. The iteration stops when the function returns false. The tree is unlocked
+// while the function is running, so it can use the tree methods, but the behaviour of this
+// function isn't defined if you modify the tree inside of the function or in another thread while
+// this method is executing.
+func (bt *BTree) Range(b Bounds, f func(Item) bool) {
+	bt.mutex.RLock()
+	defer bt.mutex.RUnlock()
+
+	if bt.root == nil {
+		return
+	}
+
+	walkBTree(bt.root, b, bt.compare, func(it Item) bool {
+		bt.mutex.RUnlock()
+		defer bt.mutex.RLock()
+
+		return f(it)
+	})
+}
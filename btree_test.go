@@ -0,0 +1,379 @@
+package mygostructs
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+	"time"
+)
+
+// checkBTreeNode checks the number of items and children of the node tree, and returns its
+// depth and its items sorted.
+func checkBTreeNode(t *testing.T, node *bTreeNode, degree int, root bool) (int, []int) {
+	var (
+		depth  int
+		values []int
+	)
+
+	if !root {
+		assert.GreaterOrEqual(t, len(node.items), degree-1, "node has few items")
+	}
+
+	assert.LessOrEqual(t, len(node.items), 2*degree-1, "node has a lot of items")
+
+	if node.leaf() {
+		for _, it := range node.items {
+			values = append(values, it.(IntItem).value)
+		}
+
+		return 0, values
+	}
+
+	assert.Equal(t, len(node.children), len(node.items)+1, "number of children is invalid")
+
+	for i, child := range node.children {
+		childDepth, childValues := checkBTreeNode(t, child, degree, false)
+
+		if i == 0 {
+			depth = childDepth
+		}
+
+		assert.Equal(t, childDepth, depth, "the leaves have different depth")
+		values = append(values, childValues...)
+
+		if i < len(node.items) {
+			values = append(values, node.items[i].(IntItem).value)
+		}
+	}
+
+	return depth + 1, values
+}
+
+// checkBTree checks the items and the properties of the B-tree.
+func checkBTree(t *testing.T, bt *BTree, values []int) {
+	treeValues := []int{}
+
+	if bt.root != nil {
+		_, treeValues = checkBTreeNode(t, bt.root, bt.degree, true)
+		assert.NotEqual(t, len(bt.root.items), 0, "the root is empty")
+	}
+
+	assert.Equal(t, treeValues, values, "items don't match")
+	assert.Equal(t, bt.length, len(values), "length doesn't match")
+}
+
+func bTreeChangeProp(bt *BTree, size int, done chan bool) {
+	for i := 0; i < size; i++ {
+		bt.mutex.Lock()
+		root, length := bt.root, bt.length
+		bt.root, bt.length = nil, -1
+		time.Sleep(time.Nanosecond)
+		bt.root, bt.length = root, length
+		bt.mutex.Unlock()
+	}
+
+	done <- true
+}
+
+func Test_NewBTree_func(t *testing.T) {
+	bt := NewBTree(16)
+
+	assert.Nil(t, bt.root, "btree root isn't nil")
+	assert.Equal(t, bt.length, 0, "btree length is incorrect")
+	assert.Equal(t, bt.degree, 16, "btree degree is incorrect")
+	assert.False(t, bt.duplicated, "btree duplicated flag is incorrect")
+
+	bt = NewBTree(1)
+	assert.Equal(t, bt.degree, 2, "btree degree is incorrect")
+}
+
+func Test_NewBTreeWith_func(t *testing.T) {
+	as := assert.New(t)
+	bt := NewBTreeWith(1, WithComparator(reverse), WithCapacity(50), WithLock(false))
+
+	as.Equal(bt.degree, 2, "btree degree is incorrect")
+	as.True(bt.mutex.disabled, "btree lock isn't disabled")
+
+	for num := 0; num < 100; num++ {
+		as.Equal(bt.Insert(It(num)), num < 50, "insert of %d is incorrect", num)
+	}
+
+	expected := []int{}
+	for num := 49; num >= 0; num-- {
+		expected = append(expected, num)
+	}
+
+	checkBTree(t, &bt, expected)
+
+	values := []int{}
+	bt.Range(Bounds{It(10), It(5), true, false}, func(it Item) bool {
+		values = append(values, it.(IntItem).value)
+		return true
+	})
+
+	as.Equal(values, []int{10, 9, 8, 7, 6}, "range items don't match")
+
+	for num := 0; num < 50; num += 2 {
+		item, _ := bt.Delete(It(num))
+		as.Equal(item, It(num), "item deleted is incorrect")
+	}
+
+	_, found := bt.Search(It(7))
+	as.True(found, "item not found")
+
+	bt = NewBTreeWith(2, WithDuplicates(true), WithComparator(lastDigit))
+	for num := 0; num < 20; num++ {
+		as.True(bt.Insert(It(num)), "duplicated item wasn't inserted")
+	}
+
+	as.Equal(bt.Length(), 20, "length doesn't match")
+}
+
+func Test_BTree_Insert_func(t *testing.T) {
+	as := assert.New(t)
+
+	for _, degree := range []int{2, 3, 8} {
+		bt := NewBTree(degree)
+
+		for num := 0; num < 500; num++ {
+			item := It(num * 37 % 500)
+			as.True(bt.Insert(item), "item %s wasn't inserted", item)
+		}
+
+		as.False(bt.Insert(It(5)), "duplicated item was inserted")
+		checkBTree(t, &bt, intRange(0, 500))
+
+		// duplicated items
+		bt = BTree{degree: degree, duplicated: true}
+		expected := []int{}
+
+		for num := 0; num < 200; num++ {
+			as.True(bt.Insert(It(num%10)), "duplicated item wasn't inserted")
+			expected = append(expected, num%10)
+		}
+
+		sort.Ints(expected)
+		checkBTree(t, &bt, expected)
+	}
+}
+
+func Test_BTree_Insert_func_sync(t *testing.T) {
+	as := assert.New(t)
+	bt := NewBTree(4)
+	done := make(chan bool)
+	concurrence := 8
+	size := 1000
+
+	insert := func(min, max int) {
+		for i := min; i < max; i++ {
+			item := It(i)
+			as.Truef(bt.Insert(item), "item %s is duplicated", item)
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go insert(i*size, (i+1)*size)
+		go bTreeChangeProp(&bt, size, done)
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+		<-done
+	}
+
+	checkBTree(t, &bt, intRange(0, size*concurrence))
+}
+
+func Test_BTree_Search_func(t *testing.T) {
+	as := assert.New(t)
+	bt := NewBTree(3)
+
+	for i := 0; i < 100; i++ {
+		bt.Insert(It(i * 2))
+	}
+
+	for i := 0; i < 100; i++ {
+		item := It(i * 2)
+		result, found := bt.Search(item)
+		as.True(found, "item %s not found", item)
+		as.Truef(result.Eq(item), "item found doesn't match")
+
+		item = It(i*2 + 1)
+		result, found = bt.Search(item)
+		as.False(found, "item %s found", item)
+		as.Nil(result, "result isn't nil")
+	}
+}
+
+func Test_BTree_Delete_func(t *testing.T) {
+	as := assert.New(t)
+
+	for _, degree := range []int{2, 3, 8} {
+		for _, duplicated := range []bool{false, true} {
+			bt := BTree{degree: degree, duplicated: duplicated}
+			expected := map[int]int{}
+
+			for num := 0; num < 600; num++ {
+				value := num * 7 % 300
+				if bt.Insert(It(value)) {
+					expected[value]++
+				}
+			}
+
+			for num := 0; num < 400; num++ {
+				value := num * 11 % 400
+				item, deleted := bt.Delete(It(value))
+				msg := fmt.Sprintf("delete %d", value)
+
+				as.Equal(deleted, expected[value] > 0, "%s: invalid result", msg)
+				if deleted {
+					as.Equal(item, It(value), "%s: invalid item", msg)
+					expected[value]--
+				}
+			}
+
+			values := []int{}
+			for value, count := range expected {
+				for ; count > 0; count-- {
+					values = append(values, value)
+				}
+			}
+
+			sort.Ints(values)
+			checkBTree(t, &bt, values)
+		}
+	}
+
+	// delete all items
+	bt := NewBTree(2)
+	for num := 0; num < 100; num++ {
+		bt.Insert(It(num))
+	}
+
+	for num := 99; num >= 0; num-- {
+		_, deleted := bt.Delete(It(num))
+		as.True(deleted, "item %d wasn't deleted", num)
+	}
+
+	checkBTree(t, &bt, []int{})
+	as.Nil(bt.root, "root isn't nil")
+}
+
+func Test_BTree_Delete_func_sync(t *testing.T) {
+	as := assert.New(t)
+	bt := NewBTree(4)
+	size := 1000
+	concurrence := 8
+	done := make(chan bool)
+	deleted := func(min, max int) {
+		for i := min; i < max; i++ {
+			value, deleted := bt.Delete(It(i))
+			as.True(deleted, "item %d wasn't deleted", i)
+			as.Equal(value.(IntItem).value, i, "the value deleted is incorrect")
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < size*concurrence; i++ {
+		bt.Insert(It(i))
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go deleted(i*size, (i+1)*size)
+		go bTreeChangeProp(&bt, size, done)
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+		<-done
+	}
+
+	checkBTree(t, &bt, []int{})
+}
+
+func Test_BTree_Length_func(t *testing.T) {
+	bt := NewBTree(2)
+
+	for i := 1; i <= 10; i++ {
+		bt.Insert(It(i))
+		assert.Equalf(t, bt.Length(), i, "tree length doesn't match")
+	}
+}
+
+func Test_BTree_Clear_func(t *testing.T) {
+	bt := NewBTree(2)
+
+	for i := 1; i <= 10; i++ {
+		bt.Insert(It(i))
+	}
+
+	bt.Clear()
+	assert.Nil(t, bt.root, "tree is empty, but root isn't nil")
+	assert.Equal(t, bt.length, 0, "tree is empty, but length isn't 0")
+	assert.Equal(t, bt.degree, 2, "degree property is incorrect")
+}
+
+func Test_BTree_Range_func(t *testing.T) {
+	as := assert.New(t)
+	bt := BTree{degree: 2, duplicated: true}
+
+	for _, num := range []int{5, 1, 3, 7, 9, 3, 5, 2, 8, 5, 5, 5} {
+		bt.Insert(It(num))
+	}
+
+	results := []struct {
+		bounds Bounds
+		values []int
+	}{
+		{Bounds{}, []int{1, 2, 3, 3, 5, 5, 5, 5, 5, 7, 8, 9}},
+		{Bounds{It(3), It(7), true, true}, []int{3, 3, 5, 5, 5, 5, 5, 7}},
+		{Bounds{It(3), It(7), false, false}, []int{5, 5, 5, 5, 5}},
+		{Bounds{It(5), It(5), true, true}, []int{5, 5, 5, 5, 5}},
+		{Bounds{nil, It(3), false, false}, []int{1, 2}},
+		{Bounds{It(6), nil, false, false}, []int{7, 8, 9}},
+		{Bounds{It(10), nil, true, false}, []int{}},
+	}
+
+	for _, result := range results {
+		values := []int{}
+		bt.Range(result.bounds, func(it Item) bool {
+			values = append(values, it.(IntItem).value)
+			return true
+		})
+
+		as.Equal(values, result.values, "range %v is invalid", result.bounds)
+	}
+
+	// stop the iteration
+	count := 0
+	bt.Traverse(func(Item) bool {
+		count++
+		return count < 3
+	})
+	as.Equal(count, 3, "the iteration didn't stop")
+
+	// the function can use the methods of the tree.
+	bt = NewBTree(2)
+	for num := 0; num < 10; num++ {
+		bt.Insert(It(num))
+	}
+
+	values := []int{}
+	bt.Traverse(func(it Item) bool {
+		_, found := bt.Search(it)
+		as.True(found, "item %s not found", it)
+		as.Equal(bt.Length(), 10, "length doesn't match")
+
+		as.True(bt.mutex.TryLock(), "tree is locked while the function is running")
+		bt.mutex.Unlock()
+
+		values = append(values, it.(IntItem).value)
+		return true
+	})
+
+	as.Equal(values, intRange(0, 10), "items visited don't match")
+}
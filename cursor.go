@@ -0,0 +1,249 @@
+package mygostructs
+
+// Cursor is an independent position in a List. Each cursor has its own position, so several
+// goroutines can iterate the same list at the same time, each one with its cursor, without moving
+// the internal pointer of the list. The cursors that only move and read take the read lock of the
+// list, so they run in parallel.
+//
+// A cursor must be used by only one goroutine. If the item pointed by the cursor is deleted by
+// another cursor or by the list, the cursor is detached: Get, Replace, Delete, AddAfter and
+// AddBefore fail, Next and Advance move the cursor to the first item that followed the deleted
+// item, and Prev and Rewind move it to the last item that preceded the deleted item. When the
+// list is cleared, the cursor must be moved with First or Last.
+type Cursor struct {
+	list *List     // list of the cursor.
+	node *listNode // node pointed. It is nil if the list was empty when the cursor was moved.
+}
+
+// Cursor returns a new cursor of the list, pointing to the first item.
+func (l *List) Cursor() *Cursor {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	return &Cursor{l, l.fnode}
+}
+
+// CursorAt returns a new cursor of the list, pointing to the item in the pos position. The second
+// value returned is false if the position doesn't exist.
+func (l *List) CursorAt(pos int) (*Cursor, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	node := l.nodeAt(pos)
+	if node == nil {
+		return nil, false
+	}
+
+	return &Cursor{l, node}, true
+}
+
+// valid checks if the cursor points to a node of its list. The list must be locked.
+func (c *Cursor) valid() bool {
+	return c.list.contains(c.node)
+}
+
+// following returns the first node of the list after the node of the cursor, walking the links
+// of the deleted nodes. The list must be locked.
+func (c *Cursor) following() *listNode {
+	node := c.node.next
+	for node != nil && !c.list.contains(node) {
+		node = node.next
+	}
+
+	return node
+}
+
+// preceding returns the last node of the list before the node of the cursor, walking the links of
+// the deleted nodes. The list must be locked.
+func (c *Cursor) preceding() *listNode {
+	node := c.node.prev
+	for node != nil && !c.list.contains(node) {
+		node = node.prev
+	}
+
+	return node
+}
+
+// Next moves the cursor to the next item. Returns a flag indicating if the operation was possible.
+func (c *Cursor) Next() bool {
+	_, moved := c.Advance()
+	return moved
+}
+
+// Prev moves the cursor to the previous item. Returns a flag indicating if the operation was
+// possible.
+func (c *Cursor) Prev() bool {
+	_, moved := c.Rewind()
+	return moved
+}
+
+// First moves the cursor to the first item of the list.
+func (c *Cursor) First() {
+	c.list.mutex.RLock()
+	c.node = c.list.fnode
+	c.list.mutex.RUnlock()
+}
+
+// Last moves the cursor to the last item of the list.
+func (c *Cursor) Last() {
+	c.list.mutex.RLock()
+	c.node = c.list.lnode
+	c.list.mutex.RUnlock()
+}
+
+// Advance advances the cursor one position and returns the item pointed. The second value
+// returned is a flag indicating if the operation was successfully.
+func (c *Cursor) Advance() (Item, bool) {
+	c.list.mutex.RLock()
+	defer c.list.mutex.RUnlock()
+
+	if c.node == nil {
+		return nil, false
+	}
+
+	if next := c.following(); next != nil {
+		c.node = next
+		return next.item, true
+	}
+
+	return nil, false
+}
+
+// Rewind rewinds the cursor one position and returns the item pointed. The second value returned
+// is a flag indicating if the operation was successfully.
+func (c *Cursor) Rewind() (Item, bool) {
+	c.list.mutex.RLock()
+	defer c.list.mutex.RUnlock()
+
+	if c.node == nil {
+		return nil, false
+	}
+
+	if prev := c.preceding(); prev != nil {
+		c.node = prev
+		return prev.item, true
+	}
+
+	return nil, false
+}
+
+// Get returns the item pointed by the cursor. The second value returned is a flag indicating if
+// the item exists. It is false if the list is empty or the item was deleted.
+func (c *Cursor) Get() (Item, bool) {
+	c.list.mutex.RLock()
+	defer c.list.mutex.RUnlock()
+
+	if !c.valid() {
+		return nil, false
+	}
+
+	return c.node.item, true
+}
+
+// Index returns the position of the item pointed by the cursor, starting from 0. The second value
+// returned is false if the cursor doesn't point to an item, because the list is empty or the item
+// was deleted.
+func (c *Cursor) Index() (int, bool) {
+	c.list.mutex.RLock()
+	defer c.list.mutex.RUnlock()
+
+	if !c.valid() {
+		return -1, false
+	}
+
+	return indexOf(c.node), true
+}
+
+// Search searchs the item in the list and moves the cursor to it. Returns the item searched and a
+// flag indicating if the item was found. If the item isn't found, the cursor doesn't move. If the
+// item is duplicated, the cursor moves to the first equal item of the list.
+func (c *Cursor) Search(it Item) (Item, bool) {
+	c.list.mutex.RLock()
+	defer c.list.mutex.RUnlock()
+
+	node := c.list.firstNode(it)
+	if node == nil {
+		return nil, false
+	}
+
+	c.node = node
+	return c.node.item, true
+}
+
+// insert inserts the item using the insert function of the list and moves the cursor to the new
+// item. If the list is empty, the item is inserted as the only item and the internal pointer of
+// the list points to it. Returns a flag indicating if the item was added.
+func (c *Cursor) insert(it Item, insert func(pos *listNode, it Item) *listNode) bool {
+	c.list.mutex.Lock()
+	defer c.list.mutex.Unlock()
+
+	if c.list.fnode != nil && !c.valid() {
+		return false
+	}
+
+	if c.list.fnode == nil {
+		c.node = nil
+	}
+
+	node := insert(c.node, it)
+	if node == nil {
+		return false
+	}
+
+	if c.list.pnode == nil {
+		c.list.pnode = node
+	}
+
+	c.node = node
+	return true
+}
+
+// AddAfter adds the item after the item pointed by the cursor and moves the cursor to the new
+// item. Returns a flag indicating if the item was added successfully. The item isn't added if it
+// is duplicated, the list is full or the item pointed by the cursor was deleted.
+func (c *Cursor) AddAfter(it Item) bool {
+	return c.insert(it, c.list.insertAfter)
+}
+
+// AddBefore adds the item before the item pointed by the cursor and moves the cursor to the new
+// item. Returns a flag indicating if the item was added successfully. The item isn't added if it
+// is duplicated, the list is full or the item pointed by the cursor was deleted.
+func (c *Cursor) AddBefore(it Item) bool {
+	return c.insert(it, c.list.insertBefore)
+}
+
+// Replace replaces the item pointed by the cursor by the item of parameter. Returns a flag
+// indicating if the operation was successfully. The item isn't replaced if it is duplicated or
+// the item pointed by the cursor was deleted.
+func (c *Cursor) Replace(it Item) bool {
+	c.list.mutex.Lock()
+	defer c.list.mutex.Unlock()
+
+	if !c.valid() {
+		return false
+	}
+
+	return c.list.replace(c.node, it)
+}
+
+// Delete deletes the item pointed by the cursor and moves the cursor to the next item, or to the
+// previous item if the item deleted was the last. The second value indicates if the item was
+// deleted. If the internal pointer of the list points to the item, it is moved to the begining of
+// the list, as in the Delete function of the list.
+func (c *Cursor) Delete() (Item, bool) {
+	c.list.mutex.Lock()
+	defer c.list.mutex.Unlock()
+
+	if !c.valid() {
+		return nil, false
+	}
+
+	node := c.node
+	c.list.remove(node)
+
+	if c.node = node.next; c.node == nil {
+		c.node = node.prev
+	}
+
+	return node.item, true
+}
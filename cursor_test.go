@@ -0,0 +1,332 @@
+package mygostructs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// cursorList returns a list with the items from min to max - 1.
+func cursorList(min, max int) *List {
+	list := NewList(false)
+	for num := min; num < max; num++ {
+		list.AddAfter(It(num))
+	}
+
+	return &list
+}
+
+// listItems returns the values of the list items, from the begining.
+func listItems(l *List) []int {
+	values := []int{}
+	l.ForEach(func(it Item) {
+		values = append(values, it.(IntItem).value)
+	})
+
+	return values
+}
+
+// checkCursor checks the item pointed by the cursor. If value is nil, the cursor mustn't point to
+// any item.
+func checkCursor(t *testing.T, c *Cursor, value *int) {
+	item, found := c.Get()
+
+	if value == nil {
+		assert.False(t, found, "cursor points to an item")
+		return
+	}
+
+	if assert.True(t, found, "cursor doesn't point to an item") {
+		assert.Equal(t, item, It(*value), "item pointed is incorrect")
+	}
+}
+
+func Test_List_Cursor_func(t *testing.T) {
+	list := NewList(false)
+	c := list.Cursor()
+
+	assert.True(t, c.list == &list, "list of the cursor is incorrect")
+	checkCursor(t, c, nil)
+
+	c = cursorList(0, 5).Cursor()
+	checkCursor(t, c, ip(0))
+}
+
+func Test_List_CursorAt_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 5)
+
+	c, found := list.CursorAt(3)
+	as.True(found, "position not found")
+	checkCursor(t, c, ip(3))
+
+	pos, valid := c.Index()
+	as.True(valid, "cursor isn't valid")
+	as.Equal(pos, 3, "position of the cursor is incorrect")
+
+	list.RemoveAt(0)
+	pos, _ = c.Index()
+	as.Equal(pos, 2, "position of the cursor isn't updated")
+
+	list.RemoveAt(2)
+	pos, valid = c.Index()
+	as.False(valid, "detached cursor is valid")
+	as.Equal(pos, -1, "position of detached cursor isn't -1")
+
+	c, found = list.CursorAt(3)
+	as.False(found, "position out of range found")
+	as.Nil(c, "cursor isn't nil")
+}
+
+func Test_Cursor_navigation_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 5)
+	c := list.Cursor()
+
+	for num := 1; num < 5; num++ {
+		item, moved := c.Advance()
+		as.True(moved, "cursor wasn't advanced")
+		as.Equal(item, It(num), "item advanced is incorrect")
+	}
+
+	_, moved := c.Advance()
+	as.False(moved, "cursor advanced after the last item")
+	as.False(c.Next(), "cursor moved after the last item")
+	checkCursor(t, c, ip(4))
+
+	for num := 3; num >= 0; num-- {
+		item, moved := c.Rewind()
+		as.True(moved, "cursor wasn't rewound")
+		as.Equal(item, It(num), "item rewound is incorrect")
+	}
+
+	as.False(c.Prev(), "cursor moved before the first item")
+
+	c.Last()
+	checkCursor(t, c, ip(4))
+	as.True(c.Prev(), "cursor wasn't moved")
+	checkCursor(t, c, ip(3))
+
+	c.First()
+	checkCursor(t, c, ip(0))
+	as.True(c.Next(), "cursor wasn't moved")
+	checkCursor(t, c, ip(1))
+
+	item, found := c.Search(It(3))
+	as.True(found, "item not found")
+	as.Equal(item, It(3), "item found is incorrect")
+	checkCursor(t, c, ip(3))
+
+	_, found = c.Search(It(10))
+	as.False(found, "item found")
+	checkCursor(t, c, ip(3))
+
+	// the internal pointer of the list doesn't move.
+	item, _ = list.Get()
+	as.Equal(item, It(4), "internal pointer moved")
+
+	// the cursors are independent.
+	other := list.Cursor()
+	checkCursor(t, other, ip(0))
+	checkCursor(t, c, ip(3))
+
+	empty := NewList(false)
+	c = empty.Cursor()
+	as.False(c.Next(), "cursor moved in empty list")
+	as.False(c.Prev(), "cursor moved in empty list")
+}
+
+func Test_Cursor_AddAfter_func(t *testing.T) {
+	as := assert.New(t)
+	list := NewList(false)
+	c := list.Cursor()
+
+	as.True(c.AddAfter(It(1)), "item wasn't added in empty list")
+	checkCursor(t, c, ip(1))
+	as.True(c.AddAfter(It(3)), "item wasn't added")
+	c.First()
+	as.True(c.AddAfter(It(2)), "item wasn't added")
+	checkCursor(t, c, ip(2))
+	as.False(c.AddAfter(It(3)), "duplicated item was added")
+
+	as.Equal(listItems(&list), []int{1, 2, 3}, "items don't match")
+	checkln(t, list.lnode, 3, ip(2), nil)
+
+	item, _ := list.Get()
+	as.Equal(item, It(1), "internal pointer moved")
+}
+
+func Test_Cursor_AddBefore_func(t *testing.T) {
+	as := assert.New(t)
+	list := NewList(false)
+	c := list.Cursor()
+
+	as.True(c.AddBefore(It(3)), "item wasn't added in empty list")
+	as.True(c.AddBefore(It(1)), "item wasn't added")
+	checkCursor(t, c, ip(1))
+	c.Last()
+	as.True(c.AddBefore(It(2)), "item wasn't added")
+	as.False(c.AddBefore(It(1)), "duplicated item was added")
+
+	as.Equal(listItems(&list), []int{1, 2, 3}, "items don't match")
+	checkln(t, list.fnode, 1, nil, ip(2))
+}
+
+func Test_Cursor_Replace_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 3)
+	c := list.Cursor()
+
+	c.Next()
+	as.True(c.Replace(It(10)), "item wasn't replaced")
+	checkCursor(t, c, ip(10))
+	as.False(c.Replace(It(2)), "duplicated item was replaced")
+	checkCursor(t, c, ip(10))
+
+	as.Equal(listItems(list), []int{0, 10, 2}, "items don't match")
+
+	_, found := list.Search(It(1))
+	as.False(found, "item replaced found")
+	_, found = list.Search(It(10))
+	as.True(found, "new item not found")
+	_, found = list.Search(It(2))
+	as.True(found, "item not found")
+
+	empty := NewList(false)
+	as.False(empty.Cursor().Replace(It(1)), "item replaced in empty list")
+}
+
+func Test_Cursor_Delete_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 4)
+	c := list.Cursor()
+
+	c.Next()
+	item, deleted := c.Delete()
+	as.True(deleted, "item wasn't deleted")
+	as.Equal(item, It(1), "item deleted is incorrect")
+	checkCursor(t, c, ip(2))
+
+	// the last item is deleted, so the cursor moves to the previous.
+	c.Last()
+	c.Delete()
+	checkCursor(t, c, ip(2))
+
+	as.Equal(listItems(list), []int{0, 2}, "items don't match")
+	as.Equal(list.Length(), 2, "length doesn't match")
+
+	c.Delete()
+	c.Delete()
+	checkCursor(t, c, nil)
+	as.Equal(list.Length(), 0, "list isn't empty")
+
+	_, deleted = c.Delete()
+	as.False(deleted, "item deleted in empty list")
+
+	// the internal pointer of the list is moved to the begining if it is deleted.
+	list = cursorList(0, 3)
+	c = list.Cursor()
+	c.Last()
+	c.Delete()
+
+	item, _ = list.Get()
+	as.Equal(item, It(0), "internal pointer wasn't moved")
+}
+
+func Test_Cursor_detached_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 6)
+	c := list.Cursor()
+	other := list.Cursor()
+
+	// the item pointed is deleted by another cursor.
+	c.Search(It(2))
+	other.Search(It(2))
+	other.Delete()
+
+	checkCursor(t, c, nil)
+	as.False(c.Replace(It(10)), "detached cursor replaced an item")
+	as.False(c.AddAfter(It(10)), "detached cursor added an item")
+	as.False(c.AddBefore(It(10)), "detached cursor added an item")
+	_, deleted := c.Delete()
+	as.False(deleted, "detached cursor deleted an item")
+
+	// the next and previous items are deleted too.
+	other.Search(It(3))
+	other.Delete()
+	other.Search(It(1))
+	other.Delete()
+
+	item, moved := c.Advance()
+	as.True(moved, "cursor wasn't moved")
+	as.Equal(item, It(4), "item advanced is incorrect")
+
+	c.Search(It(4))
+	list.Search(It(4))
+	list.Delete()
+
+	item, moved = c.Rewind()
+	as.True(moved, "cursor wasn't moved")
+	as.Equal(item, It(0), "item rewound is incorrect")
+	as.Equal(listItems(list), []int{0, 5}, "items don't match")
+
+	// the items of a cleared list are detached.
+	list.Clear()
+	checkCursor(t, c, nil)
+	as.False(c.Next(), "cursor moved in cleared list")
+
+	list.AddAfter(It(0))
+	checkCursor(t, c, nil)
+	as.False(c.AddAfter(It(1)), "detached cursor added an item")
+
+	c.First()
+	checkCursor(t, c, ip(0))
+	as.True(c.AddAfter(It(1)), "item wasn't added")
+	as.Equal(listItems(list), []int{0, 1}, "items don't match")
+
+	// a cursor of a list whose items were deleted inserts in the empty list.
+	list.First()
+	list.Delete()
+	list.Delete()
+	as.True(c.AddBefore(It(5)), "item wasn't added in empty list")
+	as.Equal(listItems(list), []int{5}, "items don't match")
+}
+
+func Test_Cursor_func_sync(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 1000)
+	concurrence := 4
+	done := make(chan bool)
+
+	// each goroutine iterates the list with its own cursor.
+	iterate := func() {
+		c := list.Cursor()
+		count := 1
+		for c.Next() {
+			count++
+		}
+
+		as.Equal(count, 1000, "cursor didn't visit all items")
+		done <- true
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go iterate()
+	}
+
+	// while a cursor modifies the items.
+	go func() {
+		c := list.Cursor()
+		for i := 0; i < 1000; i++ {
+			c.Replace(It(i + 1000))
+			c.Next()
+		}
+
+		done <- true
+	}()
+
+	for i := 0; i <= concurrence; i++ {
+		<-done
+	}
+
+	as.Equal(listItems(list), intRange(1000, 2000), "items don't match")
+}
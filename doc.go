@@ -8,4 +8,11 @@
 //  - Stack
 //  - Binary search tree
 //  - AVL tree
+//  - Persistent AVL tree
+//  - Red-black tree
+//  - Treap
+//  - Splay tree
+//  - B-tree
+//  - Skip list
+//  - Interval tree
 package mygostructs
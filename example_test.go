@@ -124,6 +124,146 @@ func ExampleTree_Clear() {
 	// Number of items is 0.
 }
 
+func ExampleTree_Traverse() {
+	tree := Tree{}
+
+	for _, i := range []int{3, 1, 5, 2, 4} {
+		tree.Insert(It(i))
+	}
+
+	// Visit the items sorted.
+	tree.Traverse(InOrder, func(it Item) bool {
+		fmt.Printf("Item %s.\n", it.String())
+		return true
+	})
+
+	// Output:
+	// Item 1.
+	// Item 2.
+	// Item 3.
+	// Item 4.
+	// Item 5.
+}
+
+func ExampleTree_TraverseReverse() {
+	tree := Tree{}
+
+	for i := 1; i <= 10; i++ {
+		tree.Insert(It(i))
+	}
+
+	// Visit the 3 greatest items.
+	count := 0
+	tree.TraverseReverse(InOrder, func(it Item) bool {
+		fmt.Printf("Item %s.\n", it.String())
+		count++
+		return count < 3
+	})
+
+	// Output:
+	// Item 10.
+	// Item 9.
+	// Item 8.
+}
+
+func ExampleTree_Floor() {
+	tree := Tree{}
+
+	for _, i := range []int{10, 20, 30} {
+		tree.Insert(It(i))
+	}
+
+	if it, found := tree.Floor(It(25)); found {
+		fmt.Printf("Floor of 25 is %s.\n", it.String())
+	}
+
+	if it, found := tree.Ceiling(It(25)); found {
+		fmt.Printf("Ceiling of 25 is %s.\n", it.String())
+	}
+
+	if _, found := tree.Floor(It(5)); !found {
+		fmt.Printf("Floor of 5 not found.\n")
+	}
+
+	// Output:
+	// Floor of 25 is 20.
+	// Ceiling of 25 is 30.
+	// Floor of 5 not found.
+}
+
+func ExampleTree_Successor() {
+	tree := Tree{}
+
+	for _, i := range []int{10, 20, 30} {
+		tree.Insert(It(i))
+	}
+
+	if it, found := tree.Predecessor(It(20)); found {
+		fmt.Printf("Predecessor of 20 is %s.\n", it.String())
+	}
+
+	if it, found := tree.Successor(It(20)); found {
+		fmt.Printf("Successor of 20 is %s.\n", it.String())
+	}
+
+	// Output:
+	// Predecessor of 20 is 10.
+	// Successor of 20 is 30.
+}
+
+func ExampleTree_Range() {
+	tree := Tree{}
+
+	for i := 1; i <= 10; i++ {
+		tree.Insert(It(i))
+	}
+
+	// Items in the range [4, 7)
+	tree.Range(Bounds{From: It(4), To: It(7), FromIncl: true}, func(it Item) bool {
+		fmt.Printf("Item %s.\n", it.String())
+		return true
+	})
+
+	// Output:
+	// Item 4.
+	// Item 5.
+	// Item 6.
+}
+
+func ExampleTree_DeleteRange() {
+	tree := Tree{}
+
+	for i := 1; i <= 10; i++ {
+		tree.Insert(It(i))
+	}
+
+	// Delete the items greater than 3.
+	deleted := tree.DeleteRange(Bounds{From: It(3)})
+	fmt.Printf("%d items deleted. Number of items is %d.\n", deleted, tree.Length())
+
+	// Output:
+	// 7 items deleted. Number of items is 3.
+}
+
+func ExampleTree_Select() {
+	tree := Tree{}
+
+	for i := 1; i <= 100; i++ {
+		tree.Insert(It(i * 10))
+	}
+
+	// Get the percentile 90.
+	if it, found := tree.Select(tree.Length() * 90 / 100); found {
+		fmt.Printf("Percentile 90 is %s.\n", it.String())
+	}
+
+	fmt.Printf("There are %d items less than 255.\n", tree.Rank(It(255)))
+
+	// Output:
+	// Percentile 90 is 910.
+	// There are 25 items less than 255.
+}
+
 // Basic usage
 func ExampleAvl() {
 	// create new avl
@@ -148,6 +288,94 @@ func ExampleAvl() {
 	// Item 2 deleted.
 }
 
+func ExampleAvl_Split() {
+	avl := NewAvl()
+
+	for i := 1; i <= 10; i++ {
+		avl.Insert(It(i))
+	}
+
+	left, right := avl.Split(It(4))
+	fmt.Printf("Lengths after split: %d and %d.\n", left.Length(), right.Length())
+
+	// Join the trees again.
+	left.Join(right)
+	fmt.Printf("Lengths after join: %d and %d.\n", left.Length(), right.Length())
+
+	// Output:
+	// Lengths after split: 3 and 7.
+	// Lengths after join: 10 and 0.
+}
+
+func ExampleAvl_Union() {
+	a, b := NewAvl(), NewAvl()
+
+	for _, i := range []int{1, 2, 3, 4} {
+		a.Insert(It(i))
+	}
+
+	for _, i := range []int{3, 4, 5} {
+		b.Insert(It(i))
+	}
+
+	fmt.Printf("Union has %d items.\n", a.Union(&b).Length())
+	fmt.Printf("Intersection has %d items.\n", a.Intersection(&b).Length())
+	fmt.Printf("Difference has %d items.\n", a.Difference(&b).Length())
+	fmt.Printf("Symmetric difference has %d items.\n", a.SymmetricDifference(&b).Length())
+
+	// Output:
+	// Union has 5 items.
+	// Intersection has 2 items.
+	// Difference has 2 items.
+	// Symmetric difference has 3 items.
+}
+
+// Basic usage
+func ExamplePersistentAvl() {
+	// create new persistent AVL tree. Each insert returns a new version.
+	v1 := NewPersistentAvl()
+	for i := 1; i <= 5; i++ {
+		v1, _ = v1.Insert(It(i))
+	}
+
+	// the old version doesn't change.
+	v2, _, _ := v1.Delete(It(3))
+	v2, _ = v2.Insert(It(6))
+
+	fmt.Printf("Version 1 has %d items.\n", v1.Length())
+	fmt.Printf("Version 2 has %d items.\n", v2.Length())
+
+	if _, found := v1.Search(It(3)); found {
+		fmt.Println("Item 3 found in version 1.")
+	}
+
+	if _, found := v2.Search(It(3)); !found {
+		fmt.Println("Item 3 not found in version 2.")
+	}
+
+	// Output:
+	// Version 1 has 5 items.
+	// Version 2 has 5 items.
+	// Item 3 found in version 1.
+	// Item 3 not found in version 2.
+}
+
+func ExampleAvl_Snapshot() {
+	avl := NewAvl()
+	for i := 1; i <= 5; i++ {
+		avl.Insert(It(i))
+	}
+
+	// the snapshot can be read in other goroutine without locks, while the tree changes.
+	snapshot := avl.Snapshot()
+	avl.Clear()
+
+	fmt.Printf("Tree: %d items. Snapshot: %d items.\n", avl.Length(), snapshot.Length())
+
+	// Output:
+	// Tree: 0 items. Snapshot: 5 items.
+}
+
 // Basic usage
 func ExampleBst() {
 	// create new Bst
@@ -172,6 +400,280 @@ func ExampleBst() {
 	// Item 2 deleted.
 }
 
+func ExampleNewAvlFromSlice() {
+	items := []Item{}
+	for i := 1; i <= 1000; i++ {
+		items = append(items, It(i))
+	}
+
+	avl := NewAvlFromSlice(items)
+	fmt.Printf("Number of items is %d.\n", avl.Length())
+
+	// Output:
+	// Number of items is 1000.
+}
+
+func ExampleNewAvlWith() {
+	// AVL tree sorted from the greatest to the smallest item, with 3 items at most.
+	avl := NewAvlWith(
+		WithComparator(func(a, b Item) int {
+			return b.(IntItem).value - a.(IntItem).value
+		}),
+		WithCapacity(3),
+	)
+
+	for i := 1; i <= 5; i++ {
+		fmt.Printf("Insert %d: %t\n", i, avl.Insert(It(i)))
+	}
+
+	avl.Traverse(InOrder, func(it Item) bool {
+		fmt.Printf("%s ", it)
+		return true
+	})
+
+	// Output:
+	// Insert 1: true
+	// Insert 2: true
+	// Insert 3: true
+	// Insert 4: false
+	// Insert 5: false
+	// 3 2 1
+}
+
+func ExampleNewAvlOf() {
+	avl := NewAvlOf[string]()
+	avl.Insert("banana")
+	avl.Insert("apple")
+	avl.Insert("cherry")
+
+	// the values are returned with their type, without type assertions.
+	first, _ := avl.Min()
+	fmt.Printf("First fruit: %s (%d letters)\n", first, len(first))
+
+	// Output:
+	// First fruit: apple (5 letters)
+}
+
+func ExampleNewSortedListOfFunc() {
+	type task struct {
+		name     string
+		priority int
+	}
+
+	// the tasks are sorted by their priority.
+	list := NewSortedListOfFunc(func(a, b task) int {
+		return a.priority - b.priority
+	})
+
+	list.Add(task{"deploy", 3})
+	list.Add(task{"build", 1})
+	list.Add(task{"test", 2})
+
+	list.ForEach(func(t task) {
+		fmt.Println(t.name)
+	})
+
+	// Output:
+	// build
+	// test
+	// deploy
+}
+
+// Basic usage
+func ExampleRbt() {
+	// create new red-black tree
+	rbt := NewRbt()
+
+	for i := 1; i <= 5; i++ {
+		rbt.Insert(It(i))
+	}
+
+	// Search the item 3
+	if item, found := rbt.Search(It(3)); found {
+		fmt.Printf("Item %s found.\n", item.String())
+	}
+
+	// Delete the item 2
+	if itemDeleted, deleted := rbt.Delete(It(2)); deleted {
+		fmt.Printf("Item %s deleted.\n", itemDeleted.String())
+	}
+
+	// Output:
+	// Item 3 found.
+	// Item 2 deleted.
+}
+
+// Basic usage
+func ExampleTreap() {
+	// create new treap. The seed makes the shape of the tree reproducible.
+	treap := NewTreapSeed(1)
+
+	for i := 1; i <= 10; i++ {
+		treap.Insert(It(i))
+	}
+
+	// Split the treap in the items less than 4 and the rest.
+	left, right := treap.Split(It(4))
+	fmt.Printf("Lengths after split: %d and %d.\n", left.Length(), right.Length())
+
+	// Merge them again.
+	left.Merge(right)
+	fmt.Printf("Lengths after merge: %d and %d.\n", left.Length(), right.Length())
+
+	// Output:
+	// Lengths after split: 3 and 7.
+	// Lengths after merge: 10 and 0.
+}
+
+// Basic usage
+func ExampleSplay() {
+	// create new splay tree
+	splay := NewSplay()
+
+	for i := 1; i <= 5; i++ {
+		splay.Insert(It(i))
+	}
+
+	// Search the item 3. The item is moved to the root of the tree, so the next search is
+	// faster.
+	if item, found := splay.Search(It(3)); found {
+		fmt.Printf("Item %s found.\n", item.String())
+	}
+
+	// Delete the item 2
+	if itemDeleted, deleted := splay.Delete(It(2)); deleted {
+		fmt.Printf("Item %s deleted.\n", itemDeleted.String())
+	}
+
+	// Output:
+	// Item 3 found.
+	// Item 2 deleted.
+}
+
+// Basic usage
+func ExampleBTree() {
+	// create new B-tree whose nodes have between 31 and 63 items.
+	btree := NewBTree(32)
+
+	for i := 1; i <= 1000; i++ {
+		btree.Insert(It(i))
+	}
+
+	// Search the item 3
+	if item, found := btree.Search(It(3)); found {
+		fmt.Printf("Item %s found.\n", item.String())
+	}
+
+	// Delete the item 2
+	if itemDeleted, deleted := btree.Delete(It(2)); deleted {
+		fmt.Printf("Item %s deleted.\n", itemDeleted.String())
+	}
+
+	// Output:
+	// Item 3 found.
+	// Item 2 deleted.
+}
+
+func ExampleBTree_Range() {
+	btree := NewBTree(4)
+
+	for i := 1; i <= 100; i++ {
+		btree.Insert(It(i))
+	}
+
+	btree.Range(Bounds{From: It(50), To: It(53), FromIncl: true}, func(it Item) bool {
+		fmt.Printf("Item %s.\n", it.String())
+		return true
+	})
+
+	// Output:
+	// Item 50.
+	// Item 51.
+	// Item 52.
+}
+
+// Basic usage
+func ExampleSkipList() {
+	// create new skip list. The seed makes the levels of the list reproducible.
+	skipList := NewSkipListSeed(1)
+
+	for i := 1; i <= 10; i++ {
+		skipList.Insert(It(i))
+	}
+
+	// Search the item 3
+	if item, found := skipList.Search(It(3)); found {
+		fmt.Printf("Item %s found.\n", item.String())
+	}
+
+	// Delete the item 2
+	if itemDeleted, deleted := skipList.Delete(It(2)); deleted {
+		fmt.Printf("Item %s deleted.\n", itemDeleted.String())
+	}
+
+	// Output:
+	// Item 3 found.
+	// Item 2 deleted.
+}
+
+func ExampleSkipList_Range() {
+	skipList := NewSkipListSeed(1)
+
+	for i := 1; i <= 10; i++ {
+		skipList.Insert(It(i))
+	}
+
+	// the list isn't locked, so the function can modify it.
+	skipList.Range(Bounds{From: It(4), To: It(7)}, func(it Item) bool {
+		skipList.Delete(it)
+		return true
+	})
+
+	skipList.Traverse(func(it Item) bool {
+		fmt.Printf("Item %s.\n", it.String())
+		return true
+	})
+
+	// Output:
+	// Item 1.
+	// Item 2.
+	// Item 3.
+	// Item 4.
+	// Item 7.
+	// Item 8.
+	// Item 9.
+	// Item 10.
+}
+
+// Basic usage
+func ExampleIntervalTree() {
+	// create new interval tree with the reserved time slots.
+	slots := NewIntervalTree()
+
+	slots.Insert(NewInterval(It(9), It(11)))
+	slots.Insert(NewInterval(It(13), It(14)))
+	slots.Insert(NewInterval(It(10), It(12)))
+
+	// Search the slots that overlap a new reservation from 11 to 13.
+	slots.Overlapping(It(11), It(13), func(iv Interval) bool {
+		fmt.Printf("Conflict with %s.\n", iv)
+		return true
+	})
+
+	// Search the slots that contain the hour 10.
+	slots.Containing(It(10), func(iv Interval) bool {
+		fmt.Printf("Slot %s contains 10.\n", iv)
+		return true
+	})
+
+	// Output:
+	// Conflict with [9, 11].
+	// Conflict with [10, 12].
+	// Conflict with [13, 14].
+	// Slot [9, 11] contains 10.
+	// Slot [10, 12] contains 10.
+}
+
 /*
 	List
 	====
@@ -627,6 +1129,144 @@ func ExampleList_Filter() {
 	// List item: 9
 }
 
+func ExampleList_Cursor() {
+	list := NewList(false)
+	for i := 1; i <= 5; i++ {
+		list.AddAfter(It(i))
+	}
+
+	// two cursors iterate the list at the same time, without moving the internal pointer.
+	forward, backward := list.Cursor(), list.Cursor()
+	backward.Last()
+
+	for moved := true; moved; moved = forward.Next() && backward.Prev() {
+		a, _ := forward.Get()
+		b, _ := backward.Get()
+		fmt.Printf("%s %s\n", a, b)
+	}
+
+	// the item pointed by the backward cursor is deleted, so it moves to the next item.
+	forward.Search(It(2))
+	backward.Search(It(2))
+	forward.Delete()
+
+	next, _ := backward.Advance()
+	fmt.Printf("After the deleted item: %s\n", next)
+
+	// Output:
+	// 1 5
+	// 2 4
+	// 3 3
+	// 4 2
+	// 5 1
+	// After the deleted item: 3
+}
+
+func ExampleList_At() {
+	list := NewList(false)
+	for i := 1; i <= 5; i++ {
+		list.AddAfter(It(i * 10))
+	}
+
+	list.InsertAt(2, It(25))
+	list.RemoveAt(0)
+
+	item, _ := list.At(1)
+	fmt.Printf("Item in position 1: %s\n", item)
+
+	pos, _ := list.IndexOf(It(50))
+	fmt.Printf("Position of 50: %d\n", pos)
+
+	cursor, _ := list.CursorAt(3)
+	item, _ = cursor.Get()
+	fmt.Printf("Cursor in position 3: %s\n", item)
+
+	// Output:
+	// Item in position 1: 25
+	// Position of 50: 4
+	// Cursor in position 3: 40
+}
+
+func ExampleList_SearchAll() {
+	list := NewList(true)
+	for _, i := range []int{1, 2, 1, 3, 1} {
+		list.AddAfter(It(i))
+	}
+
+	first, _ := list.IndexOf(It(1))
+	last, _ := list.LastIndexOf(It(1))
+	fmt.Printf("Items 1: %v\n", list.SearchAll(It(1)))
+	fmt.Printf("Count: %d, first: %d, last: %d\n", list.CountOf(It(1)), first, last)
+
+	fmt.Printf("Deleted: %d\n", list.DeleteAll(It(1)))
+	fmt.Printf("Length: %d\n", list.Length())
+
+	// Output:
+	// Items 1: [1 1 1]
+	// Count: 3, first: 0, last: 4
+	// Deleted: 3
+	// Length: 2
+}
+
+func ExampleList_Sort() {
+	list := NewList(false)
+	for _, i := range []int{3, 1, 4, 5, 2} {
+		list.AddAfter(It(i))
+	}
+
+	show := func() {
+		items := []Item{}
+		list.ForEach(func(it Item) { items = append(items, it) })
+		fmt.Println(items)
+	}
+
+	list.Sort(nil)
+	show()
+
+	list.Reverse()
+	list.Rotate(1)
+	show()
+
+	// the cursors keep their positions and their items are swapped.
+	first, _ := list.CursorAt(0)
+	last, _ := list.CursorAt(4)
+	list.Swap(first, last)
+	show()
+
+	// Output:
+	// [1 2 3 4 5]
+	// [1 5 4 3 2]
+	// [2 5 4 3 1]
+}
+
+func ExampleList_Concat() {
+	list, other := NewList(false), NewList(false)
+	for i := 1; i <= 3; i++ {
+		list.AddAfter(It(i))
+		other.AddAfter(It(i * 10))
+	}
+
+	list.Concat(&other)
+	fmt.Printf("Length: %d, other length: %d\n", list.Length(), other.Length())
+
+	// the item 2 is duplicated, so the list doesn't change.
+	other.AddAfter(It(2))
+	fmt.Printf("Concatenated: %t\n", list.Concat(&other))
+
+	sub, _ := list.Sublist(2, 5)
+	cursor := list.Cursor()
+	list.Splice(cursor, sub)
+
+	items := []Item{}
+	list.ForEach(func(it Item) { items = append(items, it) })
+	fmt.Println(items)
+
+	// Output:
+	// Length: 6, other length: 0
+	// Concatenated: false
+	// [1 3 10 20 2 30]
+}
+
 /*
 	Queue
 	=====
@@ -0,0 +1,518 @@
+package mygostructs
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// genericItem is the item that stores a value in the generic structs. The generic structs always
+// compare their items with a comparator, so the Less and Eq functions are never used by them.
+type genericItem[T any] struct {
+	value T
+}
+
+// Less returns false. The items are compared with the comparator of the struct.
+func (gi genericItem[T]) Less(it Item) bool {
+	return false
+}
+
+// Eq returns false. The items are compared with the comparator of the struct.
+func (gi genericItem[T]) Eq(it Item) bool {
+	return false
+}
+
+// String returns the value of the gi item as string.
+func (gi genericItem[T]) String() string {
+	return fmt.Sprint(gi.value)
+}
+
+// genericComparator returns the comparator of the generic items, that compares their values using
+// the compare function.
+func genericComparator[T any](compare func(a, b T) int) Comparator {
+	return func(a, b Item) int {
+		return compare(a.(genericItem[T]).value, b.(genericItem[T]).value)
+	}
+}
+
+// genericOptions returns the options of the parameter with the comparator of the generic items
+// added at the end, so it replaces the comparator option of the user.
+func genericOptions[T any](compare func(a, b T) int, opts []Option) []Option {
+	return append(opts[:len(opts):len(opts)], WithComparator(genericComparator(compare)))
+}
+
+// genericValue returns the value stored in the it item. If the found flag is false, it returns the
+// zero value of T.
+func genericValue[T any](it Item, found bool) (T, bool) {
+	if !found {
+		var zero T
+		return zero, false
+	}
+
+	return it.(genericItem[T]).value, true
+}
+
+// QueueOf is the generic version of the Queue struct. It stores values of type T, so the values
+// don't need to implement the Item interface.
+//
+// The struct is adapted to run in multithread code.
+type QueueOf[T any] struct {
+	queue Queue
+}
+
+// NewQueueOf creates and returns a new empty generic queue with the options of the parameters. It
+// uses the same options than the NewQueueWith function.
+func NewQueueOf[T any](opts ...Option) QueueOf[T] {
+	o := newOptions(opts)
+	return QueueOf[T]{Queue{capacity: o.capacity, mutex: o.lock()}}
+}
+
+// Enqueue adds the value in the end of the queue. If the queue is full, the value isn't added.
+func (qu *QueueOf[T]) Enqueue(value T) {
+	qu.queue.Enqueue(genericItem[T]{value})
+}
+
+// TryEnqueue adds the value in the end of the queue. Returns a flag indicating if the value was
+// added or the queue is full.
+func (qu *QueueOf[T]) TryEnqueue(value T) bool {
+	return qu.queue.TryEnqueue(genericItem[T]{value})
+}
+
+// Dequeue returns and delete the first value of the queue. The second value returned is flag
+// indicating the operation was success.
+func (qu *QueueOf[T]) Dequeue() (T, bool) {
+	return genericValue[T](qu.queue.Dequeue())
+}
+
+// Front reads the first value in the queue. The second value is a flag indicating if the value
+// was read successlly.
+func (qu *QueueOf[T]) Front() (T, bool) {
+	return genericValue[T](qu.queue.Front())
+}
+
+// Length returns the number of values in the queue.
+func (qu *QueueOf[T]) Length() int {
+	return qu.queue.Length()
+}
+
+// Clear clears the queue.
+func (qu *QueueOf[T]) Clear() {
+	qu.queue.Clear()
+}
+
+// StackOf is the generic version of the Stack struct. It stores values of type T, so the values
+// don't need to implement the Item interface.
+//
+// The struct is adapted to run in multithread code.
+type StackOf[T any] struct {
+	stack Stack
+}
+
+// NewStackOf creates and returns a new empty generic stack with the options of the parameters. It
+// uses the same options than the NewStackWith function.
+func NewStackOf[T any](opts ...Option) StackOf[T] {
+	o := newOptions(opts)
+	return StackOf[T]{Stack{capacity: o.capacity, mutex: o.lock()}}
+}
+
+// Push adds the value in the top of the stack. If the stack is full, the value isn't added.
+func (st *StackOf[T]) Push(value T) {
+	st.stack.Push(genericItem[T]{value})
+}
+
+// TryPush adds the value in the top of the stack. Returns a flag indicating if the value was added
+// or the stack is full.
+func (st *StackOf[T]) TryPush(value T) bool {
+	return st.stack.TryPush(genericItem[T]{value})
+}
+
+// Pop returns and deletes the value of the top of the stack. The second value returned is a flag
+// indicating if the operation was success.
+func (st *StackOf[T]) Pop() (T, bool) {
+	return genericValue[T](st.stack.Pop())
+}
+
+// Top reads the value of the top of the stack. The second value returned is a flag indicating if
+// the value was read successfully.
+func (st *StackOf[T]) Top() (T, bool) {
+	return genericValue[T](st.stack.Top())
+}
+
+// Length returns the number of values in the stack.
+func (st *StackOf[T]) Length() int {
+	return st.stack.Length()
+}
+
+// Clear clears the stack.
+func (st *StackOf[T]) Clear() {
+	st.stack.Clear()
+}
+
+// TreeOf contains the functions of the generic trees, BstOf and AvlOf. It works as the Tree
+// struct, using values of type T instead of items.
+type TreeOf[T any] struct {
+	tree Tree
+}
+
+// Insert inserts the value in the tree. The function returns a flag indicating if the operation
+// was success or the value cannot be inserted because it was duplicated or the tree is full.
+func (tr *TreeOf[T]) Insert(value T) bool {
+	return tr.tree.Insert(genericItem[T]{value})
+}
+
+// Search searchs the value in the tree. It returns the value found and a flag indicating if the
+// value exists in the tree.
+func (tr *TreeOf[T]) Search(value T) (T, bool) {
+	return genericValue[T](tr.tree.Search(genericItem[T]{value}))
+}
+
+// Delete deletes the value of the tree. Returns the value deleted and a flag indicating if the
+// value existed in the tree.
+func (tr *TreeOf[T]) Delete(value T) (T, bool) {
+	return genericValue[T](tr.tree.Delete(genericItem[T]{value}))
+}
+
+// Length returns the number of values in the tree.
+func (tr *TreeOf[T]) Length() int {
+	return tr.tree.Length()
+}
+
+// Clear clears the tree.
+func (tr *TreeOf[T]) Clear() {
+	tr.tree.Clear()
+}
+
+// Traverse executes the function of the parameter with the values of the tree, in the order of
+// the parameter. It works as the Traverse function of the Tree struct.
+func (tr *TreeOf[T]) Traverse(order TraversalOrder, f func(T) bool) {
+	tr.tree.Traverse(order, func(it Item) bool {
+		return f(it.(genericItem[T]).value)
+	})
+}
+
+// Min returns the smallest value of the tree. The second value returned is false if the tree is
+// empty.
+func (tr *TreeOf[T]) Min() (T, bool) {
+	return genericValue[T](tr.tree.Min())
+}
+
+// Max returns the greatest value of the tree. The second value returned is false if the tree is
+// empty.
+func (tr *TreeOf[T]) Max() (T, bool) {
+	return genericValue[T](tr.tree.Max())
+}
+
+// Floor returns the greatest value of the tree less than or equal to the value of the parameter.
+// The second value returned is a flag indicating if the value exists.
+func (tr *TreeOf[T]) Floor(value T) (T, bool) {
+	return genericValue[T](tr.tree.Floor(genericItem[T]{value}))
+}
+
+// Ceiling returns the smallest value of the tree greater than or equal to the value of the
+// parameter. The second value returned is a flag indicating if the value exists.
+func (tr *TreeOf[T]) Ceiling(value T) (T, bool) {
+	return genericValue[T](tr.tree.Ceiling(genericItem[T]{value}))
+}
+
+// Rank returns the number of values of the tree less than the value of the parameter.
+func (tr *TreeOf[T]) Rank(value T) int {
+	return tr.tree.Rank(genericItem[T]{value})
+}
+
+// Select returns the k-th smallest value of the tree, starting from 0. The second value returned
+// is false if k is out of range.
+func (tr *TreeOf[T]) Select(k int) (T, bool) {
+	return genericValue[T](tr.tree.Select(k))
+}
+
+// BstOf is the generic version of the Bst struct. It stores values of type T, so the values don't
+// need to implement the Item interface.
+//
+// The struct is adapted to run in multithread code.
+type BstOf[T any] struct {
+	TreeOf[T]
+}
+
+// NewBstOf returns an empty generic Bst with the options of the parameters. The values are
+// compared with the < and == operators. It uses the same options than the NewBstWith function,
+// except the comparator.
+func NewBstOf[T cmp.Ordered](opts ...Option) BstOf[T] {
+	return NewBstOfFunc(cmp.Compare[T], opts...)
+}
+
+// NewBstOfFunc returns an empty generic Bst that compares the values with the compare function.
+// The function returns a negative number if a is less than b, 0 if they are equal and a positive
+// number if a is greater than b.
+func NewBstOfFunc[T any](compare func(a, b T) int, opts ...Option) BstOf[T] {
+	o := newOptions(genericOptions(compare, opts))
+	return BstOf[T]{TreeOf[T]{newTree(false, o)}}
+}
+
+// AvlOf is the generic version of the Avl struct. It stores values of type T, so the values don't
+// need to implement the Item interface.
+//
+// The struct is adapted to run in multithread code.
+type AvlOf[T any] struct {
+	TreeOf[T]
+}
+
+// NewAvlOf creates an empty generic AVL tree with the options of the parameters. The values are
+// compared with the < and == operators. It uses the same options than the NewAvlWith function,
+// except the comparator.
+func NewAvlOf[T cmp.Ordered](opts ...Option) AvlOf[T] {
+	return NewAvlOfFunc(cmp.Compare[T], opts...)
+}
+
+// NewAvlOfFunc creates an empty generic AVL tree that compares the values with the compare
+// function. The function returns a negative number if a is less than b, 0 if they are equal and a
+// positive number if a is greater than b.
+func NewAvlOfFunc[T any](compare func(a, b T) int, opts ...Option) AvlOf[T] {
+	o := newOptions(genericOptions(compare, opts))
+	return AvlOf[T]{TreeOf[T]{newTree(true, o)}}
+}
+
+// ListOf is the generic version of the List struct. It stores values of type T, so the values
+// don't need to implement the Item interface.
+//
+// The struct is adapted to run in multithread code.
+type ListOf[T any] struct {
+	list *List
+}
+
+// NewListOf returns an empty generic List with the options of the parameters. The values are
+// compared with the < and == operators. It uses the same options than the NewListWith function,
+// except the comparator.
+func NewListOf[T cmp.Ordered](opts ...Option) ListOf[T] {
+	return NewListOfFunc(cmp.Compare[T], opts...)
+}
+
+// NewListOfFunc returns an empty generic List that searchs the values with the compare function.
+// The function returns a negative number if a is less than b, 0 if they are equal and a positive
+// number if a is greater than b.
+func NewListOfFunc[T any](compare func(a, b T) int, opts ...Option) ListOf[T] {
+	list := NewListWith(genericOptions(compare, opts)...)
+	return ListOf[T]{&list}
+}
+
+// AddAfter adds the value after the value pointed by internal pointer and moves the internal
+// pointer to the new value inserted. Returns a flag indicating if the value was added successfully.
+func (l *ListOf[T]) AddAfter(value T) bool {
+	return l.list.AddAfter(genericItem[T]{value})
+}
+
+// AddBefore adds the value before the value pointed by internal pointer and moves the internal
+// pointer to the new value inserted. Returns a flag indicating if the value was added successfully.
+func (l *ListOf[T]) AddBefore(value T) bool {
+	return l.list.AddBefore(genericItem[T]{value})
+}
+
+// Next moves the internal pointer to the next value. Returns a flag indicating if the operation
+// was possible.
+func (l *ListOf[T]) Next() bool {
+	return l.list.Next()
+}
+
+// Prev moves the internal pointer to the previous value. Returns a flag indicating if the
+// operation was possible.
+func (l *ListOf[T]) Prev() bool {
+	return l.list.Prev()
+}
+
+// First moves the internal pointer to the first value of the list.
+func (l *ListOf[T]) First() {
+	l.list.First()
+}
+
+// Last moves the internal pointer to the last value of the list.
+func (l *ListOf[T]) Last() {
+	l.list.Last()
+}
+
+// Advance advances the internal pointer one position and returns the value pointed. The second
+// value returned is a flag indicating if the operation was successfully.
+func (l *ListOf[T]) Advance() (T, bool) {
+	return genericValue[T](l.list.Advance())
+}
+
+// Rewind rewinds the internal pointer one position and returns the value pointed. The second value
+// returned is a flag indicating if the operation was successfully.
+func (l *ListOf[T]) Rewind() (T, bool) {
+	return genericValue[T](l.list.Rewind())
+}
+
+// Get gets the value pointed by the internal pointer. Returns the value and a flag indicating if
+// it was possible get the value.
+func (l *ListOf[T]) Get() (T, bool) {
+	return genericValue[T](l.list.Get())
+}
+
+// Replace replaces the value pointed by the internal pointer by the value of parameter. Returns a
+// flag indicating if the operatio was successfully.
+func (l *ListOf[T]) Replace(value T) bool {
+	return l.list.Replace(genericItem[T]{value})
+}
+
+// Search searchs the value in the list and moves the internal pointer to it. Returns the value
+// searched and a flag indicating if the value was found.
+func (l *ListOf[T]) Search(value T) (T, bool) {
+	return genericValue[T](l.list.Search(genericItem[T]{value}))
+}
+
+// Delete deletes the value pointed by the internal pointer and it moves the internal pointer to
+// the begining of the list. The second value indicates if the value was deleted.
+func (l *ListOf[T]) Delete() (T, bool) {
+	return genericValue[T](l.list.Delete())
+}
+
+// Length returns the number of values in the list.
+func (l *ListOf[T]) Length() int {
+	return l.list.Length()
+}
+
+// Clear clears the list.
+func (l *ListOf[T]) Clear() {
+	l.list.Clear()
+}
+
+// ForEach excutes the function of the parameter in all values of the list, consecutively and from
+// the begining. It works as the ForEach function of the List struct.
+func (l *ListOf[T]) ForEach(f func(T)) {
+	l.list.ForEach(func(it Item) {
+		f(it.(genericItem[T]).value)
+	})
+}
+
+// Map creates a new list using the results of parser function execution in all values of the
+// list. It works as the Map function of the List struct.
+func (l *ListOf[T]) Map(parser func(T) T) *ListOf[T] {
+	return &ListOf[T]{l.list.Map(func(it Item) Item {
+		return genericItem[T]{parser(it.(genericItem[T]).value)}
+	})}
+}
+
+// Filter creates a new list with all values that pass the test implemented in the filter
+// function. It works as the Filter function of the List struct.
+func (l *ListOf[T]) Filter(filter func(T) bool) *ListOf[T] {
+	return &ListOf[T]{l.list.Filter(func(it Item) bool {
+		return filter(it.(genericItem[T]).value)
+	})}
+}
+
+// SortedListOf is the generic version of the SortedList struct. It stores values of type T, so the
+// values don't need to implement the Item interface.
+//
+// The struct is adapted to run in multithread code.
+type SortedListOf[T any] struct {
+	sorted SortedList
+}
+
+// NewSortedListOf creates and returns a new empty generic sorted list with the options of the
+// parameters. The values are sorted with the < and == operators. It uses the same options than
+// the NewSortedListWith function, except the comparator.
+func NewSortedListOf[T cmp.Ordered](opts ...Option) SortedListOf[T] {
+	return NewSortedListOfFunc(cmp.Compare[T], opts...)
+}
+
+// NewSortedListOfFunc creates and returns a new empty generic sorted list that sorts the values
+// with the compare function. The function returns a negative number if a is less than b, 0 if
+// they are equal and a positive number if a is greater than b.
+func NewSortedListOfFunc[T any](compare func(a, b T) int, opts ...Option) SortedListOf[T] {
+	return SortedListOf[T]{NewSortedListWith(genericOptions(compare, opts)...)}
+}
+
+// Add adds the value to the sorted list. Returns a flag indicating if the value was added
+// successfully. The value isn't added if it is duplicated or the list is full.
+func (so *SortedListOf[T]) Add(value T) bool {
+	return so.sorted.Add(genericItem[T]{value})
+}
+
+// Next moves the internal pointer to the next value. Returns a flag indicating if the operation
+// was possible.
+func (so *SortedListOf[T]) Next() bool {
+	return so.sorted.Next()
+}
+
+// Prev moves the internal pointer to the previous value. Returns a flag indicating if the
+// operation was possible.
+func (so *SortedListOf[T]) Prev() bool {
+	return so.sorted.Prev()
+}
+
+// First moves the internal pointer to the first value of the list.
+func (so *SortedListOf[T]) First() {
+	so.sorted.First()
+}
+
+// Last moves the internal pointer to the last value of the list.
+func (so *SortedListOf[T]) Last() {
+	so.sorted.Last()
+}
+
+// Advance advances the internal pointer one position and returns the value pointed. The second
+// value returned is a flag indicating if the operation was successfully.
+func (so *SortedListOf[T]) Advance() (T, bool) {
+	return genericValue[T](so.sorted.Advance())
+}
+
+// Rewind rewinds the internal pointer one position and returns the value pointed. The second value
+// returned is a flag indicating if the operation was successfully.
+func (so *SortedListOf[T]) Rewind() (T, bool) {
+	return genericValue[T](so.sorted.Rewind())
+}
+
+// Get returns the value pointed by the internal pointer. The second value returned is a flag
+// indicating if the value was returned or the value doesn't exist because the list is empty.
+func (so *SortedListOf[T]) Get() (T, bool) {
+	return genericValue[T](so.sorted.Get())
+}
+
+// Search searchs the value in the list and moves the internal pointer to it. It returns the value
+// found and a flag indicating if the value exists in the list.
+func (so *SortedListOf[T]) Search(value T) (T, bool) {
+	return genericValue[T](so.sorted.Search(genericItem[T]{value}))
+}
+
+// Delete deletes the value pointed by the internal pointer and it moves the internal pointer to
+// the begining of the list. The second value indicates if the value was deleted.
+func (so *SortedListOf[T]) Delete() (T, bool) {
+	return genericValue[T](so.sorted.Delete())
+}
+
+// Clear clears the list.
+func (so *SortedListOf[T]) Clear() {
+	so.sorted.Clear()
+}
+
+// Length returns the number of values in the list.
+func (so *SortedListOf[T]) Length() int {
+	return so.sorted.Length()
+}
+
+// ForEach excutes the function of the parameter in all values of the list, consecutively and
+// from the begining.
+func (so *SortedListOf[T]) ForEach(f func(T)) {
+	so.sorted.ForEach(func(it Item) {
+		f(it.(genericItem[T]).value)
+	})
+}
+
+// Map creates a new sorted list using the results of parser function execution in all values of
+// the list. The new values are added to the list, so they are sorted again and the duplicated
+// values are discarded if the list doesn't allow them.
+func (so *SortedListOf[T]) Map(parser func(T) T) *SortedListOf[T] {
+	list := so.sorted.list.emptyCopy()
+	result := &SortedListOf[T]{SortedList{&list}}
+
+	so.ForEach(func(value T) {
+		result.Add(parser(value))
+	})
+
+	return result
+}
+
+// Filter creates a new sorted list with all values that pass the test implemented in the filter
+// function.
+func (so *SortedListOf[T]) Filter(filter func(T) bool) *SortedListOf[T] {
+	return &SortedListOf[T]{*so.sorted.Filter(func(it Item) bool {
+		return filter(it.(genericItem[T]).value)
+	})}
+}
@@ -0,0 +1,330 @@
+package mygostructs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+// person is a struct used for testing the generic structs with values that aren't ordered.
+type person struct {
+	name string
+	age  int
+}
+
+// byAge compares the persons by their age.
+func byAge(a, b person) int {
+	return a.age - b.age
+}
+
+// listOfValues returns the values of the generic list, from the begining.
+func listOfValues[T any](l *ListOf[T]) []T {
+	values := []T{}
+	l.ForEach(func(value T) {
+		values = append(values, value)
+	})
+
+	return values
+}
+
+// sortedListOfValues returns the values of the generic sorted list, from the begining.
+func sortedListOfValues[T any](so *SortedListOf[T]) []T {
+	values := []T{}
+	so.ForEach(func(value T) {
+		values = append(values, value)
+	})
+
+	return values
+}
+
+// treeOfValues returns the values of the generic tree, sorted.
+func treeOfValues[T any](tr *TreeOf[T]) []T {
+	values := []T{}
+	tr.Traverse(InOrder, func(value T) bool {
+		values = append(values, value)
+		return true
+	})
+
+	return values
+}
+
+func Test_genericItem_func(t *testing.T) {
+	as := assert.New(t)
+	a, b := genericItem[int]{1}, genericItem[int]{2}
+
+	as.False(a.Less(b), "generic item uses Less")
+	as.False(a.Eq(a), "generic item uses Eq")
+	as.Equal(a.String(), "1", "string is incorrect")
+
+	compare := genericComparator(byAge)
+	young, old := genericItem[person]{person{"b", 1}}, genericItem[person]{person{"a", 2}}
+	as.Negative(compare(young, old), "comparator is incorrect")
+
+	value, found := genericValue[int](nil, false)
+	as.False(found, "value found")
+	as.Equal(value, 0, "value isn't the zero value")
+
+	value, found = genericValue[int](b, true)
+	as.True(found, "value not found")
+	as.Equal(value, 2, "value is incorrect")
+
+	// the comparator of the user is replaced.
+	opts := []Option{WithComparator(reverse)}
+	o := newOptions(genericOptions(strings.Compare, opts))
+	as.Positive(o.compare(genericItem[string]{"b"}, genericItem[string]{"a"}),
+		"comparator isn't replaced")
+	as.Len(opts, 1, "options of the user are modified")
+}
+
+func Test_QueueOf_func(t *testing.T) {
+	as := assert.New(t)
+	queue := NewQueueOf[string](WithCapacity(2))
+
+	_, ok := queue.Front()
+	as.False(ok, "front of empty queue found")
+
+	queue.Enqueue("a")
+	as.True(queue.TryEnqueue("b"), "value wasn't enqueued")
+	as.False(queue.TryEnqueue("c"), "value enqueued in full queue")
+	as.Equal(queue.Length(), 2, "length doesn't match")
+
+	value, _ := queue.Front()
+	as.Equal(value, "a", "front value is incorrect")
+
+	for _, expected := range []string{"a", "b"} {
+		value, ok = queue.Dequeue()
+		as.True(ok, "value wasn't dequeued")
+		as.Equal(value, expected, "value dequeued is incorrect")
+	}
+
+	value, ok = queue.Dequeue()
+	as.False(ok, "value dequeued in empty queue")
+	as.Equal(value, "", "value isn't the zero value")
+
+	queue.Enqueue("a")
+	queue.Clear()
+	as.Equal(queue.Length(), 0, "queue isn't empty")
+}
+
+func Test_StackOf_func(t *testing.T) {
+	as := assert.New(t)
+	stack := NewStackOf[person](WithLock(false))
+
+	as.True(stack.stack.mutex.disabled, "stack lock isn't disabled")
+
+	stack.Push(person{"a", 1})
+	as.True(stack.TryPush(person{"b", 2}), "value wasn't pushed")
+	as.Equal(stack.Length(), 2, "length doesn't match")
+
+	value, _ := stack.Top()
+	as.Equal(value, person{"b", 2}, "top value is incorrect")
+
+	value, _ = stack.Pop()
+	as.Equal(value, person{"b", 2}, "value popped is incorrect")
+	value, _ = stack.Pop()
+	as.Equal(value, person{"a", 1}, "value popped is incorrect")
+
+	_, ok := stack.Pop()
+	as.False(ok, "value popped in empty stack")
+
+	stack.Push(person{"a", 1})
+	stack.Clear()
+	as.Equal(stack.Length(), 0, "stack isn't empty")
+}
+
+func Test_AvlOf_func(t *testing.T) {
+	as := assert.New(t)
+	avl := NewAvlOf[int]()
+
+	for _, num := range []int{5, 3, 8, 1, 4, 7, 9} {
+		as.True(avl.Insert(num), "value %d wasn't inserted", num)
+	}
+
+	as.False(avl.Insert(5), "duplicated value was inserted")
+	as.Equal(treeOfValues(&avl.TreeOf), []int{1, 3, 4, 5, 7, 8, 9}, "values don't match")
+	as.Equal(avl.Length(), 7, "length doesn't match")
+	checkHeights(t, avl.tree.root)
+
+	value, found := avl.Search(4)
+	as.True(found, "value not found")
+	as.Equal(value, 4, "value found is incorrect")
+
+	_, found = avl.Search(6)
+	as.False(found, "value found")
+
+	value, _ = avl.Min()
+	as.Equal(value, 1, "min is incorrect")
+	value, _ = avl.Max()
+	as.Equal(value, 9, "max is incorrect")
+	value, _ = avl.Floor(6)
+	as.Equal(value, 5, "floor is incorrect")
+	value, _ = avl.Ceiling(6)
+	as.Equal(value, 7, "ceiling is incorrect")
+	as.Equal(avl.Rank(7), 4, "rank is incorrect")
+	value, _ = avl.Select(2)
+	as.Equal(value, 4, "select is incorrect")
+
+	value, deleted := avl.Delete(3)
+	as.True(deleted, "value wasn't deleted")
+	as.Equal(value, 3, "value deleted is incorrect")
+	as.Equal(treeOfValues(&avl.TreeOf), []int{1, 4, 5, 7, 8, 9}, "values don't match")
+
+	avl.Clear()
+	_, found = avl.Min()
+	as.False(found, "min found in empty tree")
+
+	// custom comparator and options.
+	people := NewAvlOfFunc(byAge, WithDuplicates(true), WithComparator(reverse))
+	people.Insert(person{"b", 30})
+	people.Insert(person{"a", 20})
+	people.Insert(person{"c", 30})
+
+	as.Equal(people.Length(), 3, "duplicated value wasn't inserted")
+	oldest, _ := people.Max()
+	as.Equal(oldest.age, 30, "max is incorrect")
+
+	youngest, _ := people.Search(person{age: 20})
+	as.Equal(youngest.name, "a", "value found is incorrect")
+}
+
+func Test_BstOf_func(t *testing.T) {
+	as := assert.New(t)
+	bst := NewBstOf[string](WithCapacity(3))
+
+	for _, s := range []string{"c", "a", "b", "d"} {
+		as.Equal(bst.Insert(s), s != "d", "insert of %s is incorrect", s)
+	}
+
+	as.Equal(treeOfValues(&bst.TreeOf), []string{"a", "b", "c"}, "values don't match")
+	as.False(bst.tree.rebalance, "bst is rebalanced")
+
+	bst = NewBstOfFunc(func(a, b string) int { return len(a) - len(b) })
+	bst.Insert("aa")
+	as.False(bst.Insert("bb"), "duplicated value was inserted")
+
+	value, _ := bst.Search("cc")
+	as.Equal(value, "aa", "value found is incorrect")
+}
+
+func Test_ListOf_func(t *testing.T) {
+	as := assert.New(t)
+	list := NewListOf[int]()
+
+	for _, num := range []int{3, 1, 2} {
+		as.True(list.AddAfter(num), "value %d wasn't added", num)
+	}
+
+	as.False(list.AddAfter(1), "duplicated value was added")
+	list.First()
+	list.AddBefore(0)
+	as.Equal(listOfValues(&list), []int{0, 3, 1, 2}, "values don't match")
+	as.Equal(list.Length(), 4, "length doesn't match")
+
+	value, _ := list.Get()
+	as.Equal(value, 0, "value pointed is incorrect")
+	value, _ = list.Advance()
+	as.Equal(value, 3, "value advanced is incorrect")
+	value, _ = list.Rewind()
+	as.Equal(value, 0, "value rewound is incorrect")
+
+	list.Last()
+	as.False(list.Next(), "pointer moved after the last value")
+	as.True(list.Prev(), "pointer wasn't moved")
+
+	value, found := list.Search(3)
+	as.True(found, "value not found")
+	as.Equal(value, 3, "value found is incorrect")
+
+	as.True(list.Replace(5), "value wasn't replaced")
+	value, _ = list.Delete()
+	as.Equal(value, 5, "value deleted is incorrect")
+	as.Equal(listOfValues(&list), []int{0, 1, 2}, "values don't match")
+
+	double := list.Map(func(num int) int { return num * 2 })
+	as.Equal(listOfValues(double), []int{0, 2, 4}, "values mapped don't match")
+
+	even := double.Filter(func(num int) bool { return num%4 == 0 })
+	as.Equal(listOfValues(even), []int{0, 4}, "values filtered don't match")
+
+	list.Clear()
+	_, found = list.Get()
+	as.False(found, "value found in empty list")
+
+	people := NewListOfFunc(byAge, WithDuplicates(true))
+	people.AddAfter(person{"a", 20})
+	people.AddAfter(person{"b", 20})
+	as.Equal(people.Length(), 2, "duplicated value wasn't added")
+}
+
+func Test_SortedListOf_func(t *testing.T) {
+	as := assert.New(t)
+	list := NewSortedListOf[string]()
+
+	for _, s := range []string{"c", "a", "d", "b"} {
+		as.True(list.Add(s), "value %s wasn't added", s)
+	}
+
+	as.False(list.Add("a"), "duplicated value was added")
+	as.Equal(sortedListOfValues(&list), []string{"a", "b", "c", "d"}, "values don't match")
+	as.Equal(list.Length(), 4, "length doesn't match")
+
+	list.First()
+	value, _ := list.Advance()
+	as.Equal(value, "b", "value advanced is incorrect")
+	as.True(list.Next(), "pointer wasn't moved")
+	as.True(list.Prev(), "pointer wasn't moved")
+	value, _ = list.Rewind()
+	as.Equal(value, "a", "value rewound is incorrect")
+
+	list.Last()
+	value, _ = list.Get()
+	as.Equal(value, "d", "value pointed is incorrect")
+
+	value, found := list.Search("c")
+	as.True(found, "value not found")
+	as.Equal(value, "c", "value found is incorrect")
+
+	value, _ = list.Delete()
+	as.Equal(value, "c", "value deleted is incorrect")
+
+	// the values mapped are sorted again.
+	mapped := list.Map(func(s string) string {
+		return map[string]string{"a": "z", "b": "y", "d": "x"}[s]
+	})
+	as.Equal(sortedListOfValues(mapped), []string{"x", "y", "z"}, "values mapped don't match")
+
+	filtered := list.Filter(func(s string) bool { return s != "b" })
+	as.Equal(sortedListOfValues(filtered), []string{"a", "d"}, "values filtered don't match")
+
+	list.Clear()
+	as.Equal(list.Length(), 0, "list isn't empty")
+
+	people := NewSortedListOfFunc(byAge)
+	people.Add(person{"b", 30})
+	people.Add(person{"a", 20})
+	people.First()
+	first, _ := people.Get()
+	as.Equal(first.name, "a", "values aren't sorted")
+}
+
+func Test_AvlOf_func_sync(t *testing.T) {
+	avl := NewAvlOf[int]()
+	done := make(chan bool)
+	concurrence := 4
+
+	for i := 0; i < concurrence; i++ {
+		go func(start int) {
+			for num := start; num < 400; num += concurrence {
+				avl.Insert(num)
+			}
+			done <- true
+		}(i)
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+	}
+
+	assert.Equal(t, treeOfValues(&avl.TreeOf), intRange(0, 400), "values don't match")
+	checkHeights(t, avl.tree.root)
+}
@@ -0,0 +1,364 @@
+package mygostructs
+
+import "fmt"
+
+// Interval is the interface of the items stored in the interval tree. The interval contains all
+// items from the Low item to the High item, both included, so Low cannot be greater than High.
+//
+// The interval tree sorts the intervals by their Low item, and the intervals with the same Low
+// item using the Less function. The Eq function must return false if the Low items are different.
+type Interval interface {
+	Item
+
+	// Low returns the lower limit of the interval.
+	Low() Item
+
+	// High returns the upper limit of the interval.
+	High() Item
+}
+
+// ItemInterval struct is an implementation of the Interval interface that uses two items as
+// limits.
+type ItemInterval struct {
+	low, high Item // Limits of the interval.
+}
+
+// NewInterval creates an interval with the items of the parameters as limits. The limits are
+// swapped if low is greater than high.
+func NewInterval(low, high Item) ItemInterval {
+	if high.Less(low) {
+		low, high = high, low
+	}
+
+	return ItemInterval{low, high}
+}
+
+// Low returns the lower limit of the interval.
+func (iv ItemInterval) Low() Item {
+	return iv.low
+}
+
+// High returns the upper limit of the interval.
+func (iv ItemInterval) High() Item {
+	return iv.high
+}
+
+// Less checks if the iv interval is less than the interval of the parameter. The intervals are
+// compared by their lower limit first and by their upper limit after.
+// The function also returns false if it paramater isn't type ItemInterval.
+func (iv ItemInterval) Less(it Item) bool {
+	ivp, valid := it.(ItemInterval)
+	return valid &&
+		(iv.low.Less(ivp.low) || !ivp.low.Less(iv.low) && iv.high.Less(ivp.high))
+}
+
+// Eq checks if the iv interval is equal to the interval of the paramater.
+// The function also returns false if it paramater isn't type ItemInterval.
+func (iv ItemInterval) Eq(it Item) bool {
+	ivp, valid := it.(ItemInterval)
+	return valid && iv.low.Eq(ivp.low) && iv.high.Eq(ivp.high)
+}
+
+// String returns the interval as string.
+func (iv ItemInterval) String() string {
+	return fmt.Sprintf("[%s, %s]", iv.low, iv.high)
+}
+
+// intervalItem is the item stored in the nodes of the interval tree.
+type intervalItem struct {
+	interval Interval
+	maxHigh  Item // Greatest High item of the intervals in the tree of the node.
+}
+
+// Less checks if the interval of the ii item is less than the interval of the parameter item.
+func (ii *intervalItem) Less(it Item) bool {
+	iip, valid := it.(*intervalItem)
+	if !valid {
+		return false
+	}
+
+	a, b := ii.interval, iip.interval
+	return a.Low().Less(b.Low()) || !b.Low().Less(a.Low()) && a.Less(b)
+}
+
+// Eq checks if the interval of the ii item is equal to the interval of the parameter item.
+func (ii *intervalItem) Eq(it Item) bool {
+	iip, valid := it.(*intervalItem)
+	return valid && ii.interval.Eq(iip.interval)
+}
+
+// String transforms and returns the interval of the ii item as an String.
+func (ii *intervalItem) String() string {
+	return ii.interval.String()
+}
+
+// updateMaxHigh calculates the max High item of the node using its interval and its children.
+func updateMaxHigh(node *treeNode) {
+	if node == nil {
+		return
+	}
+
+	item := node.item.(*intervalItem)
+	item.maxHigh = item.interval.High()
+
+	for _, child := range [2]*treeNode{node.ltree, node.rtree} {
+		if child != nil && item.maxHigh.Less(child.item.(*intervalItem).maxHigh) {
+			item.maxHigh = child.item.(*intervalItem).maxHigh
+		}
+	}
+}
+
+// fixInterval updates the size of the node, rebalances it and updates the max High items of the
+// nodes moved by the rotations. Returns the node rebalanced.
+func fixInterval(node *treeNode) *treeNode {
+	node.updateSize()
+	node = rebalance(node)
+
+	updateMaxHigh(node.ltree)
+	updateMaxHigh(node.rtree)
+	updateMaxHigh(node)
+	return node
+}
+
+// insertInterval inserts the it item in the node tree and rebalances it. If duplicated parameter
+// is false, the item inserted must be unique. The function returns the node rebalanced and a flag
+// indicating if the item was inserted.
+func insertInterval(node *treeNode, it *intervalItem, duplicated bool) (*treeNode, bool) {
+	var inserted bool
+
+	if node == nil {
+		it.maxHigh = it.interval.High()
+		return &treeNode{nil, nil, 0, 1, it}, true
+	}
+
+	if !duplicated && node.item.Eq(it) {
+		return node, false
+	}
+
+	if it.Less(node.item) {
+		node.ltree, inserted = insertInterval(node.ltree, it, duplicated)
+	} else {
+		node.rtree, inserted = insertInterval(node.rtree, it, duplicated)
+	}
+
+	if !inserted {
+		return node, false
+	}
+
+	return fixInterval(node), true
+}
+
+// deleteMinInterval deletes the node with the smallest interval of the node tree and rebalances
+// the tree. Returns the tree rebalanced and the node deleted.
+func deleteMinInterval(node *treeNode) (*treeNode, *treeNode) {
+	var min *treeNode
+
+	if node.ltree == nil {
+		return node.rtree, node
+	}
+
+	node.ltree, min = deleteMinInterval(node.ltree)
+	return fixInterval(node), min
+}
+
+// deleteInterval searchs the it item in the node tree, deletes it and rebalances the tree. The
+// function returns the tree rebalanced, the item deleted and a flag indicating if the item existed.
+func deleteInterval(node *treeNode, it Item) (*treeNode, Item, bool) {
+	var (
+		itDeleted Item
+		found     bool
+		min       *treeNode
+	)
+
+	switch {
+	case node == nil:
+		return nil, nil, false
+
+	case !node.item.Eq(it) && node.item.Less(it):
+		node.rtree, itDeleted, found = deleteInterval(node.rtree, it)
+
+	case !node.item.Eq(it):
+		node.ltree, itDeleted, found = deleteInterval(node.ltree, it)
+
+	case node.ltree == nil:
+		return node.rtree, node.item, true
+
+	case node.rtree == nil:
+		return node.ltree, node.item, true
+
+	default:
+		// The node has two children. Its item is replaced by the next item, and the node of
+		// the next item is deleted from the right subtree.
+		itDeleted, found = node.item, true
+		node.rtree, min = deleteMinInterval(node.rtree)
+		node.item = min.item
+	}
+
+	if !found {
+		return node, nil, false
+	}
+
+	return fixInterval(node), itDeleted, true
+}
+
+// walkOverlap visits, sorted, the intervals of the node tree that overlap the range from low to
+// high and executes the visit function with each interval. The subtrees whose max High item is
+// less than low, or whose intervals start after high, are skipped. The walk stops when the visit
+// function returns false. The function returns false if the walk was stopped.
+func walkOverlap(node *treeNode, low, high Item, visit func(Interval) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	item := node.item.(*intervalItem)
+	if item.maxHigh.Less(low) {
+		// all intervals of the tree end before of the range.
+		return true
+	}
+
+	if !walkOverlap(node.ltree, low, high, visit) {
+		return false
+	}
+
+	if high.Less(item.interval.Low()) {
+		// the interval and the intervals of the right subtree start after of the range.
+		return true
+	}
+
+	if !item.interval.High().Less(low) && !visit(item.interval) {
+		return false
+	}
+
+	return walkOverlap(node.rtree, low, high, visit)
+}
+
+// IntervalTree is a struct it implements an interval tree type data structure. It is an AVL tree
+// of intervals where each node stores the greatest upper limit of the intervals of its subtree,
+// so it finds quickly the intervals that overlap a range or contain a point.
+//
+// The struct is adapted to run in multithread code.
+type IntervalTree struct {
+	root       *treeNode // Tree root.
+	length     int       // Number of tree nodes.
+	duplicated bool      // Flag indicating if allows duplicated intervals.
+	capacity   int       // Max number of intervals. If it is 0, the number isn't limited.
+	mutex      rwLock    // Lock for avoid the concurrence when manipulate the struct.
+}
+
+// NewIntervalTree creates an empty interval tree.
+func NewIntervalTree() IntervalTree {
+	return NewIntervalTreeWith()
+}
+
+// NewIntervalTreeUnsync creates an empty interval tree without lock, for using it in only one
+// goroutine.
+func NewIntervalTreeUnsync() IntervalTree {
+	return NewIntervalTreeWith(WithLock(false))
+}
+
+// NewIntervalTreeWith creates an empty interval tree with the options of the parameters. The tree
+// uses the duplicated items, lock and capacity options. The intervals are always sorted by their
+// limits, so the comparator option is ignored.
+func NewIntervalTreeWith(opts ...Option) IntervalTree {
+	o := newOptions(opts)
+	return IntervalTree{duplicated: o.duplicated, capacity: o.capacity, mutex: o.lock()}
+}
+
+// Insert inserts the interval in the tree. The function returns a flag indicating if the
+// operation was success or the interval cannot be inserted because it was duplicated or the tree
+// is full.
+func (itr *IntervalTree) Insert(iv Interval) bool {
+	var inserted bool
+
+	itr.mutex.Lock()
+	defer itr.mutex.Unlock()
+
+	if isFull(itr.length, itr.capacity) {
+		return false
+	}
+
+	itr.root, inserted = insertInterval(itr.root, &intervalItem{interval: iv}, itr.duplicated)
+	if inserted {
+		itr.length++
+	}
+
+	return inserted
+}
+
+// Search searchs the interval in the tree. It returns the interval found and a flag indicating
+// if the interval exists in the tree.
+func (itr *IntervalTree) Search(iv Interval) (Interval, bool) {
+	itr.mutex.RLock()
+	defer itr.mutex.RUnlock()
+
+	if node, found := search(itr.root, &intervalItem{interval: iv}, nil); found {
+		return node.item.(*intervalItem).interval, true
+	}
+
+	return nil, false
+}
+
+// Delete deletes the interval of the tree. Returns the interval deleted and a flag indicating if
+// the interval existed in the tree.
+func (itr *IntervalTree) Delete(iv Interval) (Interval, bool) {
+	var (
+		itd     Item
+		deleted bool
+	)
+
+	itr.mutex.Lock()
+	defer itr.mutex.Unlock()
+
+	itr.root, itd, deleted = deleteInterval(itr.root, &intervalItem{interval: iv})
+	if !deleted {
+		return nil, false
+	}
+
+	itr.length--
+	return itd.(*intervalItem).interval, true
+}
+
+// Length returns the number of intervals in the tree.
+func (itr *IntervalTree) Length() int {
+	itr.mutex.RLock()
+	defer itr.mutex.RUnlock()
+
+	return itr.length
+}
+
+// Clear clears the tree.
+func (itr *IntervalTree) Clear() {
+	itr.mutex.Lock()
+	defer itr.mutex.Unlock()
+
+	itr.root = nil
+	itr.length = 0
+}
+
+// Overlapping executes the function of the parameter with the intervals of the tree that overlap
+// the range from low to high, both included, sorted. The iteration stops when the function
+// returns false. The search is O((k + 1) log n) in the worst case, where k is the number of
+// intervals found, and O(log n + k) only when all intervals found start inside of the range. The
+// walk skips the subtrees whose intervals end before low or start after high, so the intervals
+// found that start inside of the range cost O(1) amortised each, but the intervals found that
+// start before low can be deep in subtrees that cannot be skipped, and each one can cost
+// O(log n). As in the Traverse function of the Tree struct, the tree is unlocked while the
+// function is running and the behaviour of this function isn't defined if you modify the tree
+// inside of the function or in another thread while this method is executing.
+func (itr *IntervalTree) Overlapping(low, high Item, f func(Interval) bool) {
+	itr.mutex.RLock()
+	defer itr.mutex.RUnlock()
+
+	walkOverlap(itr.root, low, high, func(iv Interval) bool {
+		itr.mutex.RUnlock()
+		defer itr.mutex.RLock()
+
+		return f(iv)
+	})
+}
+
+// Containing executes the function of the parameter with the intervals of the tree that contain the
+// point, sorted. It works as the Overlapping function, using the point as range.
+func (itr *IntervalTree) Containing(point Item, f func(Interval) bool) {
+	itr.Overlapping(point, point, f)
+}
@@ -0,0 +1,420 @@
+package mygostructs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// Iv creates an ItemInterval with the numbers of the parameters as limits.
+func Iv(low, high int) ItemInterval {
+	return NewInterval(It(low), It(high))
+}
+
+// checkMaxHigh checks the max High items of the node tree. Returns the max High item.
+func checkMaxHigh(t *testing.T, node *treeNode) Item {
+	if node == nil {
+		return nil
+	}
+
+	item := node.item.(*intervalItem)
+	maxHigh := item.interval.High()
+
+	for _, child := range []*treeNode{node.ltree, node.rtree} {
+		if childMax := checkMaxHigh(t, child); childMax != nil && maxHigh.Less(childMax) {
+			maxHigh = childMax
+		}
+	}
+
+	assert.Equal(t, item.maxHigh, maxHigh, "in node %s: the max high doesn't match", item)
+	return maxHigh
+}
+
+// intervalTreeValues returns the intervals of the interval tree, sorted.
+func intervalTreeValues(itr *IntervalTree) []Interval {
+	values := []Interval{}
+
+	walk(itr.root, InOrder, false, func(node *treeNode) bool {
+		values = append(values, node.item.(*intervalItem).interval)
+		return true
+	})
+
+	return values
+}
+
+// checkIntervalTree checks the intervals and the properties of the interval tree.
+func checkIntervalTree(t *testing.T, itr *IntervalTree, values []Interval) {
+	assert.Equal(t, intervalTreeValues(itr), values, "intervals don't match")
+	assert.Equal(t, itr.length, len(values), "length doesn't match")
+	checkHeights(t, itr.root)
+	checkSizes(t, itr.root)
+	checkMaxHigh(t, itr.root)
+
+	walk(itr.root, InOrder, false, func(node *treeNode) bool {
+		diff := node.ltree.getHeight() - node.rtree.getHeight()
+		assert.True(t, diff >= -1 && diff <= 1, "node %s is unbalanced", node.item)
+		return true
+	})
+}
+
+// overlapping returns the intervals of the slice that overlap the range from low to high.
+func overlapping(intervals []Interval, low, high int) []Interval {
+	values := []Interval{}
+
+	for _, iv := range intervals {
+		if !iv.High().Less(It(low)) && !It(high).Less(iv.Low()) {
+			values = append(values, iv)
+		}
+	}
+
+	return values
+}
+
+func Test_NewInterval_func(t *testing.T) {
+	as := assert.New(t)
+	iv := NewInterval(It(5), It(2))
+
+	as.Equal(iv.Low(), It(2), "interval low is incorrect")
+	as.Equal(iv.High(), It(5), "interval high is incorrect")
+	as.Equal(iv.String(), "[2, 5]", "interval string is incorrect")
+}
+
+func Test_ItemInterval_func(t *testing.T) {
+	as := assert.New(t)
+
+	as.True(Iv(1, 5).Less(Iv(2, 3)), "interval isn't less")
+	as.True(Iv(1, 3).Less(Iv(1, 5)), "interval isn't less")
+	as.False(Iv(1, 5).Less(Iv(1, 5)), "interval is less")
+	as.False(Iv(2, 3).Less(Iv(1, 5)), "interval is less")
+	as.False(Iv(1, 5).Less(It(7)), "interval is less than an IntItem")
+
+	as.True(Iv(1, 5).Eq(Iv(1, 5)), "intervals aren't equal")
+	as.False(Iv(1, 5).Eq(Iv(1, 4)), "intervals are equal")
+	as.False(Iv(1, 1).Eq(It(1)), "interval is equal to an IntItem")
+}
+
+func Test_NewIntervalTreeWith_func(t *testing.T) {
+	as := assert.New(t)
+	itr := NewIntervalTreeWith(WithDuplicates(true), WithCapacity(3), WithLock(false))
+
+	as.True(itr.mutex.disabled, "interval tree lock isn't disabled")
+
+	for num := 0; num < 5; num++ {
+		as.Equal(itr.Insert(Iv(1, 5)), num < 3, "insert %d is incorrect", num)
+	}
+
+	checkIntervalTree(t, &itr, []Interval{Iv(1, 5), Iv(1, 5), Iv(1, 5)})
+}
+
+func Test_IntervalTree_Insert_func(t *testing.T) {
+	as := assert.New(t)
+	itr := NewIntervalTree()
+	values := []Interval{}
+
+	for num := 0; num < 100; num++ {
+		low := num * 37 % 100
+		as.True(itr.Insert(Iv(low, low+num%7)), "interval wasn't inserted")
+	}
+
+	for low := 0; low < 100; low++ {
+		values = append(values, Iv(low, low+low*73%100%7))
+	}
+
+	as.False(itr.Insert(Iv(0, 0)), "duplicated interval was inserted")
+	checkIntervalTree(t, &itr, values)
+
+	// duplicated intervals
+	itr = IntervalTree{duplicated: true}
+	for num := 0; num < 6; num++ {
+		as.True(itr.Insert(Iv(num%3, 3)), "duplicated interval wasn't inserted")
+	}
+
+	checkIntervalTree(t, &itr, []Interval{
+		Iv(0, 3), Iv(0, 3), Iv(1, 3), Iv(1, 3), Iv(2, 3), Iv(2, 3),
+	})
+}
+
+func Test_IntervalTree_Insert_func_sync(t *testing.T) {
+	as := assert.New(t)
+	itr := NewIntervalTree()
+	done := make(chan bool)
+	concurrence := 8
+	size := 500
+	values := []Interval{}
+
+	insert := func(min, max int) {
+		for i := min; i < max; i++ {
+			as.True(itr.Insert(Iv(i, i+10)), "interval is duplicated")
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go insert(i*size, (i+1)*size)
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+	}
+
+	for i := 0; i < size*concurrence; i++ {
+		values = append(values, Iv(i, i+10))
+	}
+
+	checkIntervalTree(t, &itr, values)
+}
+
+func Test_IntervalTree_Search_func(t *testing.T) {
+	as := assert.New(t)
+	itr := NewIntervalTree()
+
+	for i := 0; i < 50; i++ {
+		itr.Insert(Iv(i, i*2))
+	}
+
+	for i := 0; i < 50; i++ {
+		result, found := itr.Search(Iv(i, i*2))
+		as.True(found, "interval %s not found", Iv(i, i*2))
+		as.Equal(result, Iv(i, i*2), "interval found doesn't match")
+
+		result, found = itr.Search(Iv(i, i*2+1))
+		as.False(found, "interval %s found", Iv(i, i*2+1))
+		as.Nil(result, "result isn't nil")
+	}
+}
+
+func Test_IntervalTree_Delete_func(t *testing.T) {
+	as := assert.New(t)
+	itr := NewIntervalTree()
+	expected := []Interval{}
+
+	for num := 0; num < 100; num++ {
+		itr.Insert(Iv(num, 200-num))
+	}
+
+	for num := 0; num < 100; num++ {
+		iv := Iv(num, 200-num)
+		if num%3 != 0 {
+			expected = append(expected, iv)
+			continue
+		}
+
+		result, deleted := itr.Delete(iv)
+		as.True(deleted, "interval %s wasn't deleted", iv)
+		as.Equal(result, iv, "interval deleted is incorrect")
+		checkMaxHigh(t, itr.root)
+	}
+
+	checkIntervalTree(t, &itr, expected)
+
+	result, deleted := itr.Delete(Iv(0, 200))
+	as.False(deleted, "interval deleted twice")
+	as.Nil(result, "interval deleted isn't nil")
+
+	for _, iv := range expected {
+		itr.Delete(iv)
+	}
+
+	checkIntervalTree(t, &itr, []Interval{})
+}
+
+func Test_IntervalTree_Length_func(t *testing.T) {
+	itr := NewIntervalTree()
+
+	for i := 1; i <= 10; i++ {
+		itr.Insert(Iv(i, i))
+		assert.Equalf(t, itr.Length(), i, "interval tree length doesn't match")
+	}
+}
+
+func Test_IntervalTree_Clear_func(t *testing.T) {
+	itr := NewIntervalTree()
+
+	for i := 1; i <= 10; i++ {
+		itr.Insert(Iv(i, i))
+	}
+
+	itr.Clear()
+	assert.Nil(t, itr.root, "interval tree is empty, but root isn't nil")
+	assert.Equal(t, itr.Length(), 0, "interval tree is empty, but length isn't 0")
+}
+
+func Test_IntervalTree_Overlapping_func(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	itr := IntervalTree{duplicated: true}
+	intervals := []Interval{}
+
+	for i := 0; i < 200; i++ {
+		low := rnd.Intn(1000)
+		iv := Iv(low, low+rnd.Intn(50))
+
+		itr.Insert(iv)
+		intervals = append(intervals, iv)
+	}
+
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].Less(intervals[j])
+	})
+
+	checkIntervalTree(t, &itr, intervals)
+
+	for i := 0; i < 200; i++ {
+		low := rnd.Intn(1100) - 50
+		high := low + rnd.Intn(30)
+		values := []Interval{}
+
+		itr.Overlapping(It(low), It(high), func(iv Interval) bool {
+			values = append(values, iv)
+			return true
+		})
+
+		expected := overlapping(intervals, low, high)
+		assert.Equal(t, values, expected, "intervals in [%d, %d] don't match", low, high)
+	}
+
+	// stopped
+	values := []Interval{}
+	itr.Overlapping(It(0), It(1000), func(iv Interval) bool {
+		values = append(values, iv)
+		return len(values) < 5
+	})
+
+	assert.Equal(t, values, intervals[:5], "overlapping intervals don't match")
+}
+
+// countItem is an integer item that counts the calls to its Less function.
+type countItem struct {
+	value int
+	calls *int
+}
+
+func (ci countItem) Less(it Item) bool {
+	*ci.calls++
+	return ci.value < it.(countItem).value
+}
+
+func (ci countItem) Eq(it Item) bool {
+	return ci.value == it.(countItem).value
+}
+
+func (ci countItem) String() string {
+	return strconv.Itoa(ci.value)
+}
+
+func Test_IntervalTree_Overlapping_func_cost(t *testing.T) {
+	calls := 0
+	ci := func(value int) countItem { return countItem{value, &calls} }
+	itr := NewIntervalTree()
+	size := 1 << 12
+
+	for i := 0; i < size; i++ {
+		itr.Insert(NewInterval(ci(2*i), ci(2*i+1)))
+	}
+
+	// The intervals found start inside of the range, so each one adds a constant cost.
+	for _, k := range []int{1, 10, 100, 1000} {
+		found := 0
+		calls = 0
+		itr.Overlapping(ci(size), ci(size+2*k-1), func(iv Interval) bool {
+			found++
+			return true
+		})
+
+		assert.Equal(t, found, k, "number of intervals found is incorrect")
+		assert.LessOrEqual(t, calls, 4*(k+itr.root.height+1), "too many comparisons: %d", k)
+	}
+
+	// The intervals found are long and nested, and they start before the range, between short
+	// intervals that end before it. Each one can add a cost proportional to the tree height.
+	itr.Clear()
+	expected := []Interval{}
+	for i := 0; i < size; i++ {
+		iv := NewInterval(ci(i), ci(i))
+		if i%64 == 0 {
+			iv = NewInterval(ci(i), ci(2*size-i))
+			expected = append(expected, iv)
+		}
+
+		itr.Insert(iv)
+	}
+
+	values := []Interval{}
+	calls = 0
+	itr.Overlapping(ci(size), ci(size+1), func(iv Interval) bool {
+		values = append(values, iv)
+		return true
+	})
+
+	k := len(expected)
+	assert.Equal(t, values, expected, "intervals found don't match")
+	assert.Greater(t, calls, 4*(k+itr.root.height+1), "comparisons are linear in k: %d", calls)
+	assert.LessOrEqual(t, calls, 4*k*(itr.root.height+1), "too many comparisons: %d", calls)
+}
+
+func Test_IntervalTree_Containing_func(t *testing.T) {
+	itr := NewIntervalTree()
+
+	for _, iv := range []Interval{Iv(1, 3), Iv(2, 8), Iv(4, 6), Iv(5, 5), Iv(7, 9)} {
+		itr.Insert(iv)
+	}
+
+	results := []struct {
+		point  int
+		values []Interval
+	}{
+		{0, []Interval{}},
+		{1, []Interval{Iv(1, 3)}},
+		{3, []Interval{Iv(1, 3), Iv(2, 8)}},
+		{5, []Interval{Iv(2, 8), Iv(4, 6), Iv(5, 5)}},
+		{8, []Interval{Iv(2, 8), Iv(7, 9)}},
+		{10, []Interval{}},
+	}
+
+	for _, result := range results {
+		values := []Interval{}
+
+		itr.Containing(It(result.point), func(iv Interval) bool {
+			values = append(values, iv)
+			return true
+		})
+
+		assert.Equal(t, values, result.values, "intervals of %d don't match", result.point)
+	}
+}
+
+func Test_IntervalTree_Overlapping_func_sync(t *testing.T) {
+	itr := NewIntervalTree()
+	done := make(chan bool)
+	concurrence := 4
+	size := 200
+
+	for i := 0; i < size; i++ {
+		itr.Insert(Iv(i*10, i*10+5))
+	}
+
+	// the function can use the methods of the tree.
+	query := func() {
+		for i := 0; i < size; i++ {
+			itr.Containing(It(i*10+2), func(iv Interval) bool {
+				_, found := itr.Search(iv)
+				assert.True(t, found, "interval %s not found", iv)
+				time.Sleep(time.Nanosecond)
+				return true
+			})
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go query()
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+	}
+}
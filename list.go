@@ -1,12 +1,21 @@
 package mygostructs
 
-import "sync"
-
 // listNode is the node for the List struct.
 type listNode struct {
-	prev *listNode
-	next *listNode
-	item Item
+	prev  *listNode
+	next  *listNode
+	item  Item
+	owner *listOwner // Owner of the list that contains the node. It is nil if it was deleted.
+
+	// Links of the node in the positional index of the list.
+	parent, ltree, rtree *listNode
+	height, size         int
+}
+
+// listOwner identifies the nodes of a list, so the cursors detect if the node that they point
+// was deleted. When the list is cleared, the owner is replaced and all old nodes are detached.
+type listOwner struct {
+	_ byte // The owner isn't empty, because the pointers to empty structs can be equal.
 }
 
 // Less checks if item in the listNode is less than the the parameter item.
@@ -21,6 +30,17 @@ func (ln listNode) Eq(it Item) bool {
 	return valid && ln.item.Eq(lnn.item)
 }
 
+// Compare compares the item in the listNode with the parameter item in only one step, using the
+// Compare function of the item if it exists.
+func (ln *listNode) Compare(it Item) int {
+	lnn, valid := it.(*listNode)
+	if !valid {
+		return 1
+	}
+
+	return Comparator(nil).compare(ln.item, lnn.item)
+}
+
 //String transforms and returns the item in the listNode as an String.
 func (ln listNode) String() string {
 	return ln.item.String()
@@ -30,96 +50,336 @@ func (ln listNode) String() string {
 // linearly. It can access and manipulate any item of the list. Also it allows to search quickly
 // items.
 //
-// The struct is adapted to run in multithread code.
+// The struct is adapted to run in multithread code. The list uses a reader/writer lock. The
+// internal pointer is part of the state of the list, so the reads are the methods that neither
+// modify the items nor move the internal pointer: Get, Length, At, IndexOf, LastIndexOf,
+// SearchAll, CountOf, ForEach, Map, Filter, Cursor, CursorAt and the methods of the Cursor struct
+// that don't modify the list. They share the lock and run in parallel. The methods that move the
+// internal pointer (Next, Prev, First, Last, Advance, Rewind and Search) lock the list
+// exclusively, as the methods that modify the items. The goroutines that iterate the list at the
+// same time should use their own Cursor.
+//
+// If the list allows duplicated items, the equal items keep the order of the list: the search
+// functions return the first equal item of the list, and SearchLast returns the last.
 type List struct {
-	fnode *listNode  // pointer to the first node of the list.
-	lnode *listNode  // ponter to the last node of the list
-	pnode *listNode  // Internal pointer. It is moved using the struct functions.
-	avl   Tree       // avl tree
-	mutex sync.Mutex // Lock for avoid the concurrence when manipulate the struct.
+	fnode   *listNode  // pointer to the first node of the list.
+	lnode   *listNode  // ponter to the last node of the list
+	pnode   *listNode  // Internal pointer. It is moved using the struct functions.
+	owner   *listOwner // Owner of the nodes. It is replaced when the list is cleared.
+	index   *listNode  // Root of the positional index of the nodes.
+	avl     Tree       // avl tree
+	compare Comparator // Function for comparing the items. If nil, the item functions are used.
+	mutex   rwLock     // Lock for avoid the concurrence when manipulate the struct.
 }
 
 // NewList returns an empty List. The parameter is flag indicating if the list allows items
 // duplicated.
 func NewList(duplicated bool) List {
-	return List{avl: Tree{rebalance: true, duplicated: duplicated}}
+	return NewListWith(WithDuplicates(duplicated))
 }
 
-// AddAfter adds the item after the item pointed by internal pointer and moves the internal
-// pointer to the new item inserted. Returns a flag indicating if the item was added successfully.
-func (l *List) AddAfter(it Item) bool {
-	l.mutex.Lock()
-	defer l.mutex.Unlock()
+// NewListUnsync returns an empty List without lock. The list is faster, but it cannot be used by
+// several goroutines at the same time. The parameter is flag indicating if the list allows items
+// duplicated.
+func NewListUnsync(duplicated bool) List {
+	return NewListWith(WithDuplicates(duplicated), WithLock(false))
+}
 
-	node := listNode{}
-	node.item = it
+// NewListWith returns an empty List with the options of the parameters. The list uses all
+// options: duplicated items, comparator (used for searching the items), lock and capacity.
+func NewListWith(opts ...Option) List {
+	o := newOptions(opts)
+	return List{avl: newListTree(o), compare: o.compare, mutex: o.lock()}
+}
 
-	// Insert in tree
-	if !l.avl.Insert(&node) {
-		return false
+// newListTree creates the avl tree used by the list for searching the items, with the settings of
+// the parameter. The tree is always used with the list locked, so its own lock is disabled.
+func newListTree(o options) Tree {
+	o.compare = listComparator(o.compare, o.duplicated)
+	o.unlocked = true
+	return newTree(true, o)
+}
+
+// listComparator returns the comparator of the list nodes, that compares their items using the
+// cmp comparator. If cmp is nil, the nodes are compared with their own functions. If the list
+// allows duplicated items, the nodes of the positional index with equal items are compared by
+// their position, so the tree keeps the equal items in the order of the list and each node is
+// deleted exactly. A node outside of the index, as the nodes used for searching, is equal to all
+// nodes with an equal item.
+func listComparator(cmp Comparator, duplicated bool) Comparator {
+	var nodes Comparator
+
+	if cmp != nil {
+		nodes = func(a, b Item) int {
+			return cmp(a.(*listNode).item, b.(*listNode).item)
+		}
 	}
 
-	if l.fnode == nil {
-		// List is empty. Add the first node
-		l.fnode = &node
-		l.lnode = &node
-		l.pnode = &node
-		return true
+	if !duplicated {
+		return nodes
+	}
+
+	return func(a, b Item) int {
+		if c := nodes.compare(a, b); c != 0 {
+			return c
+		}
+
+		na, valid := a.(*listNode)
+		nb, validb := b.(*listNode)
+		if !valid || !validb || !na.indexed() || !nb.indexed() {
+			return 0
+		}
+
+		return indexOf(na) - indexOf(nb)
+	}
+}
+
+// emptyCopy returns an empty list with the settings of the l list, including its lock setting.
+func (l *List) emptyCopy() List {
+	return List{
+		avl:     l.avl.emptyCopy(),
+		compare: l.compare,
+		mutex:   rwLock{disabled: l.mutex.disabled},
 	}
+}
 
-	node.next = l.pnode.next
-	node.prev = l.pnode
-	l.pnode.next = &node
+// newNode returns a node of the l list with the it item. The node isn't linked to the list.
+func (l *List) newNode(it Item) *listNode {
+	if l.owner == nil {
+		l.owner = &listOwner{}
+	}
 
-	if node.next != nil {
-		node.next.prev = &node
+	return &listNode{item: it, owner: l.owner}
+}
+
+// contains checks if the node is in the l list. The nodes deleted of the list and the nodes of a
+// cleared list aren't in it.
+func (l *List) contains(node *listNode) bool {
+	return node != nil && node.owner != nil && node.owner == l.owner
+}
+
+// link links the node to the list after the prev node, or as the first node if prev is nil, and
+// inserts it in the positional index. The node isn't inserted in the tree.
+func (l *List) link(node, prev *listNode) {
+	node.prev = prev
+
+	if prev != nil {
+		node.next = prev.next
+		prev.next = node
+	} else {
+		// the node is the first.
+		node.next = l.fnode
+		l.fnode = node
 	}
 
-	if node.next == nil {
+	if node.next != nil {
+		node.next.prev = node
+	} else {
 		// The node has been inserted in the last position.
-		l.lnode = &node
+		l.lnode = node
 	}
 
-	l.pnode = &node
-	return true
+	l.indexInsert(node)
 }
 
-// AddBefore adds the item before the item pointed by internal pointer and moves the internal
-// pointer to the new item inserted. Returns a flag indicating if the item was added successfully
-func (l *List) AddBefore(it Item) bool {
-	l.mutex.Lock()
-	defer l.mutex.Unlock()
+// unlink unlinks the node of the list and deletes it of the positional index. The node keeps its
+// links to the previous and the next nodes. The node isn't deleted of the tree.
+func (l *List) unlink(node *listNode) {
+	l.indexRemove(node)
 
-	node := listNode{}
-	node.item = it
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		l.fnode = node.next
+	}
+
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		l.lnode = node.prev
+	}
+}
+
+// insert links the node after the prev node, or as the first node if prev is nil, and inserts it
+// in the tree. The node is linked before, because the tree compares the duplicated items by their
+// position. Returns the node inserted, or nil if the item wasn't inserted because it is
+// duplicated or the list is full. Then the node is unlinked.
+func (l *List) insert(node, prev *listNode) *listNode {
+	l.link(node, prev)
+
+	if !l.avl.Insert(node) {
+		l.unlink(node)
+		return nil
+	}
 
-	// Insert in tree
-	if !l.avl.Insert(&node) {
+	return node
+}
+
+// insertAfter inserts the it item after the pos node. If the list is empty, the item is inserted
+// as the only item, so pos must be nil. Returns the node inserted, or nil if the item wasn't
+// inserted because it is duplicated or the list is full.
+func (l *List) insertAfter(pos *listNode, it Item) *listNode {
+	return l.insert(l.newNode(it), pos)
+}
+
+// insertBefore inserts the it item before the pos node. It works as the insertAfter function.
+func (l *List) insertBefore(pos *listNode, it Item) *listNode {
+	var prev *listNode
+	if pos != nil {
+		prev = pos.prev
+	}
+
+	return l.insert(l.newNode(it), prev)
+}
+
+// replace replaces the item of the node by the it item. Returns a flag indicating if the item was
+// replaced. The item isn't replaced if it is duplicated.
+func (l *List) replace(node *listNode, it Item) bool {
+	old := node.item
+	l.avl.Delete(node)
+
+	node.item = it
+	if !l.avl.Insert(node) {
+		node.item = old
+		l.avl.Insert(node)
 		return false
 	}
 
-	if l.fnode == nil {
-		// List is empty. Add the first node
-		l.fnode = &node
-		l.lnode = &node
-		l.pnode = &node
-		return true
+	return true
+}
+
+// remove deletes the node of the list. The deleted node keeps its links to the previous and the
+// next nodes, so the cursors that point to it can continue moving. If the internal pointer points
+// to the node, it is moved to the begining of the list.
+func (l *List) remove(node *listNode) {
+	l.avl.Delete(node)
+	l.unlink(node)
+	node.owner = nil
+
+	if l.pnode == node {
+		l.pnode = l.fnode
 	}
+}
 
-	node.next = l.pnode
-	node.prev = l.pnode.prev
-	l.pnode.prev = &node
+// firstNode returns the first node of the list whose item is equal to the it item, or nil if the
+// item isn't found. The equal items are sorted in the tree by their position in the list.
+func (l *List) firstNode(it Item) *listNode {
+	var first *treeNode
+
+	bc := l.compare.bind(it)
+	for node := l.avl.root; node != nil; {
+		c := bc.compare(node.item.(*listNode).item)
+		if c == 0 {
+			first = node
+			if !l.avl.duplicated {
+				break
+			}
+		}
 
-	if node.prev != nil {
-		node.prev.next = &node
+		if c > 0 {
+			node = node.rtree
+		} else {
+			node = node.ltree
+		}
+	}
+
+	if first == nil {
+		return nil
+	}
+
+	return first.item.(*listNode)
+}
+
+// lastNode returns the last node of the list whose item is equal to the it item, or nil if the
+// item isn't found.
+func (l *List) lastNode(it Item) *listNode {
+	var last *treeNode
+
+	bc := l.compare.bind(it)
+	for node := l.avl.root; node != nil; {
+		c := bc.compare(node.item.(*listNode).item)
+		if c == 0 {
+			last = node
+			if !l.avl.duplicated {
+				break
+			}
+		}
+
+		if c >= 0 {
+			node = node.rtree
+		} else {
+			node = node.ltree
+		}
+	}
+
+	if last == nil {
+		return nil
+	}
+
+	return last.item.(*listNode)
+}
+
+// equalNodes returns the nodes of the list whose item is equal to the it item, in the order of
+// the list.
+func (l *List) equalNodes(it Item) []*listNode {
+	var (
+		nodes []*listNode
+		walk  func(*treeNode)
+	)
+
+	bc := l.compare.bind(it)
+	walk = func(node *treeNode) {
+		if node == nil {
+			return
+		}
+
+		c := bc.compare(node.item.(*listNode).item)
+		if c <= 0 {
+			walk(node.ltree)
+		}
+
+		if c == 0 {
+			nodes = append(nodes, node.item.(*listNode))
+		}
+
+		if c >= 0 {
+			walk(node.rtree)
+		}
+	}
+
+	walk(l.avl.root)
+	return nodes
+}
+
+// AddAfter adds the item after the item pointed by internal pointer and moves the internal
+// pointer to the new item inserted. Returns a flag indicating if the item was added successfully.
+// The item isn't added if it is duplicated or the list is full.
+func (l *List) AddAfter(it Item) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	node := l.insertAfter(l.pnode, it)
+	if node == nil {
+		return false
 	}
 
-	if node.prev == nil {
-		// the value inserted is the first.
-		l.fnode = &node
+	l.pnode = node
+	return true
+}
+
+// AddBefore adds the item before the item pointed by internal pointer and moves the internal
+// pointer to the new item inserted. Returns a flag indicating if the item was added successfully.
+// The item isn't added if it is duplicated or the list is full.
+func (l *List) AddBefore(it Item) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	node := l.insertBefore(l.pnode, it)
+	if node == nil {
+		return false
 	}
 
-	l.pnode = &node
+	l.pnode = node
 	return true
 }
 
@@ -198,8 +458,8 @@ func (l *List) Rewind() (Item, bool) {
 // Get gets the item pointed by the internal pointer. Returns the item and a flag indicating if
 // it was possible get the item.
 func (l *List) Get() (Item, bool) {
-	l.mutex.Lock()
-	defer l.mutex.Unlock()
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
 
 	if l.pnode != nil {
 		return l.pnode.item, true
@@ -210,7 +470,8 @@ func (l *List) Get() (Item, bool) {
 }
 
 // Replace replaces the item pointed by the internal pointer by the item of parameter.
-// Returns a flag indicating if the operatio was successfully.
+// Returns a flag indicating if the operatio was successfully. The item isn't replaced if it is
+// duplicated.
 func (l *List) Replace(it Item) bool {
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
@@ -219,98 +480,200 @@ func (l *List) Replace(it Item) bool {
 		return false //list empty
 	}
 
-	nodeReplaced := &listNode{l.pnode.prev, l.pnode.next, it}
-	l.avl.Delete(l.pnode)
+	return l.replace(l.pnode, it)
+}
 
-	if l.pnode == l.fnode {
-		l.fnode = nodeReplaced
-	} else {
-		nodeReplaced.prev.next = nodeReplaced
-	}
+// Search searchs the item in the list and moves the internal pointer to it. Returns the item
+// searched and a flag indicating if the item was found. If the item is duplicated, the first
+// equal item of the list is returned.
+func (l *List) Search(it Item) (Item, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
 
-	if l.pnode == l.lnode {
-		l.lnode = nodeReplaced
-	} else {
-		nodeReplaced.next.prev = nodeReplaced
+	if node := l.firstNode(it); node != nil {
+		l.pnode = node
+		return l.pnode.item, true
 	}
 
-	l.pnode = nodeReplaced
-	l.avl.Insert(nodeReplaced)
-
-	return true
+	return nil, false
 }
 
-// Search searchs the item in the list. Returns the item searched and a flag indicating if the
-// item was found.
-func (l *List) Search(it Item) (Item, bool) {
+// SearchLast searchs the item in the list and moves the internal pointer to it, as the Search
+// function. If the item is duplicated, the last equal item of the list is returned.
+func (l *List) SearchLast(it Item) (Item, bool) {
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
 
-	node, found := l.avl.Search(&listNode{item: it})
-	if found {
-		l.pnode = node.(*listNode)
+	if node := l.lastNode(it); node != nil {
+		l.pnode = node
 		return l.pnode.item, true
 	}
 
 	return nil, false
 }
 
+// SearchAll returns all items of the list equal to the it item, in the order of the list. The
+// internal pointer doesn't move.
+func (l *List) SearchAll(it Item) []Item {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	nodes := l.equalNodes(it)
+	items := make([]Item, len(nodes))
+	for i, node := range nodes {
+		items[i] = node.item
+	}
+
+	return items
+}
+
+// CountOf returns the number of items of the list equal to the it item. It takes O(log n) time.
+func (l *List) CountOf(it Item) int {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	bc := l.compare.bind(it)
+	return countPrefix(l.avl.root, func(nodeItem Item) bool {
+		return bc.compare(nodeItem.(*listNode).item) >= 0
+	}) - countPrefix(l.avl.root, func(nodeItem Item) bool {
+		return bc.compare(nodeItem.(*listNode).item) > 0
+	})
+}
+
 // Delete deletes the item pointed by the internal pointer and it moves the internal pointer to
 // the begining of the list. The second value indicates if the item was deleted.
 func (l *List) Delete() (Item, bool) {
-	var item Item
-
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
 
 	if l.avl.length == 0 {
-		return item, false
+		return nil, false
 	}
 
-	// Delete from tree
-	l.avl.Delete(l.pnode)
+	item := l.pnode.item
+	l.remove(l.pnode)
+	return item, true
+}
+
+// DeleteAll deletes all items of the list equal to the it item and returns the number of items
+// deleted. If the internal pointer points to an item deleted, it is moved to the begining of the
+// list, as in the Delete function.
+func (l *List) DeleteAll(it Item) int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	nodes := l.equalNodes(it)
+	for _, node := range nodes {
+		l.remove(node)
+	}
 
-	if l.avl.length == 0 {
-		item = l.pnode.item
+	return len(nodes)
+}
 
-		// create empty list.
-		l.fnode = nil
-		l.pnode = nil
-		l.lnode = nil
+// At returns the item in the pos position of the list, starting from 0. The second value returned
+// is false if the position doesn't exist. The internal pointer doesn't move.
+func (l *List) At(pos int) (Item, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
 
-		return item, true
+	if node := l.nodeAt(pos); node != nil {
+		return node.item, true
 	}
 
-	tmpPnode := l.pnode
-	item = tmpPnode.item
+	return nil, false
+}
+
+// InsertAt inserts the item in the pos position of the list, so the item in the position and the
+// items after it are moved one position. If pos is the length of the list, the item is inserted
+// at the end. Returns a flag indicating if the item was inserted. The item isn't inserted if the
+// position doesn't exist, the item is duplicated or the list is full. The internal pointer doesn't
+// move, except if the list was empty, then it points to the new item.
+func (l *List) InsertAt(pos int, it Item) bool {
+	var node *listNode
 
-	if tmpPnode.prev != nil {
-		tmpPnode.prev.next = tmpPnode.next
-	} else {
-		l.fnode = tmpPnode.next
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	length := l.index.indexSize()
+	switch {
+	case pos < 0 || pos > length:
+		return false
+	case pos == length:
+		node = l.insertAfter(l.lnode, it)
+	default:
+		node = l.insertBefore(l.nodeAt(pos), it)
 	}
 
-	if tmpPnode.next != nil {
-		tmpPnode.next.prev = tmpPnode.prev
-	} else {
-		l.lnode = tmpPnode.prev
+	if node == nil {
+		return false
 	}
 
-	l.pnode = l.fnode
-	return item, true
+	if l.pnode == nil {
+		l.pnode = node
+	}
+
+	return true
 }
 
-// Length returns the number of items in the list.
-func (l *List) Length() int {
+// RemoveAt deletes the item in the pos position of the list. Returns the item deleted and a flag
+// indicating if the position existed. If the internal pointer points to the item, it is moved to
+// the begining of the list, as in the Delete function.
+func (l *List) RemoveAt(pos int) (Item, bool) {
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
+
+	node := l.nodeAt(pos)
+	if node == nil {
+		return nil, false
+	}
+
+	l.remove(node)
+	return node.item, true
+}
+
+// IndexOf searchs the item in the list and returns its position, starting from 0. The second
+// value returned is a flag indicating if the item was found. If it isn't found, the position is
+// -1. If the item is duplicated, the position of the first equal item is returned. The internal
+// pointer doesn't move.
+func (l *List) IndexOf(it Item) (int, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	node := l.firstNode(it)
+	if node == nil {
+		return -1, false
+	}
+
+	return indexOf(node), true
+}
+
+// LastIndexOf searchs the item in the list and returns its position, as the IndexOf function. If
+// the item is duplicated, the position of the last equal item is returned.
+func (l *List) LastIndexOf(it Item) (int, bool) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	node := l.lastNode(it)
+	if node == nil {
+		return -1, false
+	}
+
+	return indexOf(node), true
+}
+
+// Length returns the number of items in the list.
+func (l *List) Length() int {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
 	return l.avl.length
 }
 
 // Clear clears the list.
 func (l *List) Clear() {
 	l.mutex.Lock()
-	l.avl = Tree{rebalance: true, duplicated: l.avl.duplicated}
+	l.avl = l.avl.emptyCopy()
+	l.owner = nil
+	l.index = nil
 	l.fnode = nil
 	l.pnode = nil
 	l.lnode = nil
@@ -327,13 +690,13 @@ func (l *List) ForEach(f func(Item)) {
 		return
 	}
 
-	l.mutex.Lock()
-	defer l.mutex.Unlock()
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
 
 	for node := l.fnode; node != nil; node = node.next {
-		l.mutex.Unlock()
+		l.mutex.RUnlock()
 		f(node.item)
-		l.mutex.Lock()
+		l.mutex.RLock()
 	}
 }
 
@@ -346,7 +709,7 @@ func (l *List) Map(parser func(Item) Item) *List {
 		forFunc func(Item)
 	)
 
-	newList = NewList(l.avl.duplicated)
+	newList = l.emptyCopy()
 	forFunc = func(it Item) {
 		newList.AddAfter(parser(it))
 	}
@@ -364,7 +727,7 @@ func (l *List) Filter(filter func(Item) bool) *List {
 		forFunc func(Item)
 	)
 
-	newList = NewList(l.avl.duplicated)
+	newList = l.emptyCopy()
 	forFunc = func(it Item) {
 		if filter(it) {
 			newList.AddAfter(it)
@@ -0,0 +1,264 @@
+package mygostructs
+
+// The positional index of the List is an AVL tree of the list nodes, sorted by their position in
+// the list instead of by their items. Each node stores the number of nodes of its subtree and a
+// pointer to its parent, so the node in a position and the position of a node are found in
+// O(log n) time.
+
+// indexHeight returns the height of the node in the index. If node is nil, returns 0.
+func (node *listNode) indexHeight() int {
+	if node == nil {
+		return 0
+	}
+
+	return node.height
+}
+
+// indexSize returns the number of nodes of the node subtree in the index. If node is nil,
+// returns 0.
+func (node *listNode) indexSize() int {
+	if node == nil {
+		return 0
+	}
+
+	return node.size
+}
+
+// indexed checks if the node is in the positional index of a list.
+func (node *listNode) indexed() bool {
+	return node.height > 0
+}
+
+// updateIndex calculates the height and the size of the node using its children.
+func (node *listNode) updateIndex() {
+	node.height = max(node.ltree.indexHeight(), node.rtree.indexHeight()) + 1
+	node.size = node.ltree.indexSize() + node.rtree.indexSize() + 1
+}
+
+// rotateIndexLeft rotates the node to the left and returns the new root of the subtree. The child
+// link of the parent of the node isn't updated.
+func rotateIndexLeft(node *listNode) *listNode {
+	root := node.rtree
+
+	node.rtree = root.ltree
+	if node.rtree != nil {
+		node.rtree.parent = node
+	}
+
+	root.ltree = node
+	root.parent = node.parent
+	node.parent = root
+
+	node.updateIndex()
+	root.updateIndex()
+	return root
+}
+
+// rotateIndexRight rotates the node to the right and returns the new root of the subtree. The
+// child link of the parent of the node isn't updated.
+func rotateIndexRight(node *listNode) *listNode {
+	root := node.ltree
+
+	node.ltree = root.rtree
+	if node.ltree != nil {
+		node.ltree.parent = node
+	}
+
+	root.rtree = node
+	root.parent = node.parent
+	node.parent = root
+
+	node.updateIndex()
+	root.updateIndex()
+	return root
+}
+
+// rebalanceIndex updates the node, rebalances it and returns the new root of the subtree.
+func rebalanceIndex(node *listNode) *listNode {
+	switch node.ltree.indexHeight() - node.rtree.indexHeight() {
+	case 2:
+		if node.ltree.ltree.indexHeight() < node.ltree.rtree.indexHeight() {
+			node.ltree = rotateIndexLeft(node.ltree)
+		}
+
+		return rotateIndexRight(node)
+
+	case -2:
+		if node.rtree.rtree.indexHeight() < node.rtree.ltree.indexHeight() {
+			node.rtree = rotateIndexRight(node.rtree)
+		}
+
+		return rotateIndexLeft(node)
+	}
+
+	node.updateIndex()
+	return node
+}
+
+// replaceIndexChild replaces the old child of the parent node by the child node in the index. If
+// parent is nil, the child is the new root of the index.
+func (l *List) replaceIndexChild(parent, old, child *listNode) {
+	if child != nil {
+		child.parent = parent
+	}
+
+	switch {
+	case parent == nil:
+		l.index = child
+	case parent.ltree == old:
+		parent.ltree = child
+	default:
+		parent.rtree = child
+	}
+}
+
+// fixIndex updates and rebalances the nodes of the index from the node to the root.
+func (l *List) fixIndex(node *listNode) {
+	for node != nil {
+		parent := node.parent
+		l.replaceIndexChild(parent, node, rebalanceIndex(node))
+		node = parent
+	}
+}
+
+// indexInsert inserts in the index the node, that was linked to the list before. The node is
+// inserted after its previous node in the list, or as the first node if it doesn't have previous.
+func (l *List) indexInsert(node *listNode) {
+	var parent *listNode
+
+	node.ltree, node.rtree, node.height, node.size = nil, nil, 1, 1
+
+	switch {
+	case l.index == nil:
+		node.parent = nil
+		l.index = node
+		return
+
+	case node.prev == nil:
+		// the node is the first, so it is the left child of the first node of the index.
+		parent = l.index
+		for parent.ltree != nil {
+			parent = parent.ltree
+		}
+
+		parent.ltree = node
+
+	case node.prev.rtree == nil:
+		parent = node.prev
+		parent.rtree = node
+
+	default:
+		// the node is the left child of the first node of the right subtree of the previous
+		// node.
+		parent = node.prev.rtree
+		for parent.ltree != nil {
+			parent = parent.ltree
+		}
+
+		parent.ltree = node
+	}
+
+	node.parent = parent
+	l.fixIndex(parent)
+}
+
+// indexRemove deletes the node of the index.
+func (l *List) indexRemove(node *listNode) {
+	var fix *listNode
+
+	if node.ltree != nil && node.rtree != nil {
+		// The node has two children. It is replaced by the next node, the first node of its
+		// right subtree.
+		next := node.rtree
+		for next.ltree != nil {
+			next = next.ltree
+		}
+
+		fix = next
+		if next != node.rtree {
+			fix = next.parent
+			l.replaceIndexChild(next.parent, next, next.rtree)
+			next.rtree = node.rtree
+			next.rtree.parent = next
+		}
+
+		next.ltree = node.ltree
+		next.ltree.parent = next
+		l.replaceIndexChild(node.parent, node, next)
+	} else {
+		child := node.ltree
+		if child == nil {
+			child = node.rtree
+		}
+
+		fix = node.parent
+		l.replaceIndexChild(node.parent, node, child)
+	}
+
+	l.fixIndex(fix)
+	node.parent, node.ltree, node.rtree = nil, nil, nil
+	node.height, node.size = 0, 0
+}
+
+// indexOf returns the position of the node in the list.
+func indexOf(node *listNode) int {
+	pos := node.ltree.indexSize()
+
+	for ; node.parent != nil; node = node.parent {
+		if node.parent.rtree == node {
+			pos += node.parent.ltree.indexSize() + 1
+		}
+	}
+
+	return pos
+}
+
+// nodeAt returns the node in the pos position of the list, or nil if the position doesn't exist.
+func (l *List) nodeAt(pos int) *listNode {
+	node := l.index
+
+	for node != nil {
+		size := node.ltree.indexSize()
+
+		switch {
+		case pos < size:
+			node = node.ltree
+		case pos == size:
+			return node
+		default:
+			pos -= size + 1
+			node = node.rtree
+		}
+	}
+
+	return nil
+}
+
+// buildIndex builds a balanced index with the n nodes of the list from the first node, following
+// the next links. It returns the root of the index and the node after the last node used.
+func buildIndex(first *listNode, n int) (*listNode, *listNode) {
+	if n == 0 {
+		return nil, first
+	}
+
+	ltree, root := buildIndex(first, n/2)
+	rtree, next := buildIndex(root.next, n-n/2-1)
+
+	root.parent, root.ltree, root.rtree = nil, ltree, rtree
+	if ltree != nil {
+		ltree.parent = root
+	}
+
+	if rtree != nil {
+		rtree.parent = root
+	}
+
+	root.updateIndex()
+	return root, next
+}
+
+// rebuildIndex builds again the positional index with the nodes of the list, in O(n) time. It is
+// used after reordering the nodes of the list.
+func (l *List) rebuildIndex() {
+	l.index, _ = buildIndex(l.fnode, l.avl.length)
+}
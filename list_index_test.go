@@ -0,0 +1,135 @@
+package mygostructs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+// checkIndexNode checks the links, the height, the size and the balance of the node subtree in
+// the positional index. It appends the nodes of the subtree to the nodes slice, sorted.
+func checkIndexNode(t *testing.T, node, parent *listNode, nodes []*listNode) []*listNode {
+	if node == nil {
+		return nodes
+	}
+
+	assert.True(t, node.parent == parent, "parent of %s is incorrect", node)
+
+	nodes = checkIndexNode(t, node.ltree, node, nodes)
+	nodes = append(nodes, node)
+	nodes = checkIndexNode(t, node.rtree, node, nodes)
+
+	lh, rh := node.ltree.indexHeight(), node.rtree.indexHeight()
+	assert.Equal(t, node.height, max(lh, rh)+1, "height of %s is incorrect", node)
+	assert.Equal(t, node.size, node.ltree.indexSize()+node.rtree.indexSize()+1,
+		"size of %s is incorrect", node)
+	assert.LessOrEqual(t, lh-rh, 1, "node %s isn't balanced", node)
+	assert.GreaterOrEqual(t, lh-rh, -1, "node %s isn't balanced", node)
+
+	return nodes
+}
+
+// checkIndex checks that the positional index of the list contains the nodes of the list in the
+// same order and that it is balanced.
+func checkIndex(t *testing.T, l *List) {
+	nodes := checkIndexNode(t, l.index, nil, nil)
+	pos := 0
+
+	for node := l.fnode; node != nil; node = node.next {
+		if !assert.Less(t, pos, len(nodes), "index hasn't all nodes") {
+			return
+		}
+
+		assert.True(t, nodes[pos] == node, "node %s isn't in position %d", node, pos)
+		pos++
+	}
+
+	assert.Equal(t, pos, len(nodes), "index has more nodes than the list")
+	assert.Equal(t, len(nodes), l.avl.length, "index length doesn't match")
+}
+
+func Test_List_index_func(t *testing.T) {
+	as := assert.New(t)
+	rnd := rand.New(rand.NewSource(1))
+	list := NewList(true)
+	expected := []int{}
+
+	// the list must contain the same items than a slice with the same operations.
+	for i := 0; i < 2000; i++ {
+		pos := rnd.Intn(len(expected) + 1)
+
+		switch op := rnd.Intn(5); {
+		case op < 2:
+			as.True(list.InsertAt(pos, It(i)), "item wasn't inserted at %d", pos)
+			expected = append(expected[:pos], append([]int{i}, expected[pos:]...)...)
+
+		case op == 2 && len(expected) > 0:
+			pos = rnd.Intn(len(expected))
+			item, _ := list.RemoveAt(pos)
+			as.Equal(item, It(expected[pos]), "item removed is incorrect")
+			expected = append(expected[:pos], expected[pos+1:]...)
+
+		case op == 3 && len(expected) > 0:
+			pos = rnd.Intn(len(expected))
+			c, _ := list.CursorAt(pos)
+			c.AddAfter(It(i))
+			pos++
+			expected = append(expected[:pos], append([]int{i}, expected[pos:]...)...)
+
+		case op == 4 && len(expected) > 0:
+			pos = rnd.Intn(len(expected))
+			c, _ := list.CursorAt(pos)
+			c.Delete()
+			expected = append(expected[:pos], expected[pos+1:]...)
+		}
+	}
+
+	checkIndex(t, &list)
+	as.Equal(listItems(&list), expected, "items don't match")
+
+	for pos, value := range expected {
+		item, _ := list.At(pos)
+		as.Equal(item, It(value), "item at %d is incorrect", pos)
+
+		index, _ := list.IndexOf(It(value))
+		as.Equal(index, pos, "index of %d is incorrect", value)
+	}
+}
+
+func Test_List_index_AddAfter_func(t *testing.T) {
+	list := NewList(false)
+
+	for i := 0; i < 100; i++ {
+		list.AddAfter(It(i))
+		list.First()
+		list.AddBefore(It(-i - 1))
+		list.Last()
+	}
+
+	checkIndex(t, &list)
+
+	list.First()
+	for i := 0; i < 50; i++ {
+		list.Next()
+		list.Delete()
+	}
+
+	checkIndex(t, &list)
+	list.Clear()
+	assert.Nil(t, list.index, "index of cleared list isn't empty")
+}
+
+func Test_SortedList_index_func(t *testing.T) {
+	list := NewSortedList(true)
+
+	for i := 0; i < 200; i++ {
+		list.Add(It(i * 7919 % 100))
+	}
+
+	checkIndex(t, list.list)
+
+	for pos := 0; pos < 200; pos++ {
+		item, _ := list.list.At(pos)
+		assert.Equal(t, item, It(pos/2), "item at %d is incorrect", pos)
+	}
+}
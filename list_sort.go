@@ -0,0 +1,260 @@
+package mygostructs
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// reorder updates the list after reordering its nodes. The positional index is built again and,
+// if the list allows duplicated items, the equal items of the tree are sorted by their new
+// position. The tree isn't rebalanced, because the items don't change.
+func (l *List) reorder() {
+	var run []*treeNode
+
+	l.rebuildIndex()
+	if !l.avl.duplicated {
+		return
+	}
+
+	// sortRun sorts the nodes of the list in the tree nodes of the run, by their position.
+	sortRun := func() {
+		if len(run) < 2 {
+			return
+		}
+
+		nodes := make([]*listNode, len(run))
+		for i, node := range run {
+			nodes[i] = node.item.(*listNode)
+		}
+
+		sort.Slice(nodes, func(a, b int) bool {
+			return indexOf(nodes[a]) < indexOf(nodes[b])
+		})
+
+		for i, node := range run {
+			node.item = nodes[i]
+		}
+	}
+
+	walk(l.avl.root, InOrder, false, func(node *treeNode) bool {
+		item := node.item.(*listNode).item
+		if len(run) > 0 && l.compare.compare(run[0].item.(*listNode).item, item) != 0 {
+			sortRun()
+			run = run[:0]
+		}
+
+		run = append(run, node)
+		return true
+	})
+
+	sortRun()
+}
+
+// relink links the nodes of the slice in the list, in the same order. The slice mustn't be empty.
+func (l *List) relink(nodes []*listNode) {
+	var prev *listNode
+
+	for _, node := range nodes {
+		node.prev = prev
+		if prev != nil {
+			prev.next = node
+		}
+
+		prev = node
+	}
+
+	prev.next = nil
+	l.fnode, l.lnode = nodes[0], prev
+}
+
+// Sort sorts the items of the list using the less function. The sort is stable, so the equal
+// items keep their order. If less is nil, the items are sorted with the comparator of the list.
+// The nodes are merged in place, in O(n log n) time, but after sorting, the positional index is
+// built again, allocating O(n) memory, and if the list allows duplicated items, the equal items
+// are sorted again in the tree by their new position. The internal pointer and the cursors point
+// to the same items after sorting.
+func (l *List) Sort(less func(a, b Item) bool) {
+	var head, tail *listNode
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.fnode == nil {
+		return
+	}
+
+	if less == nil {
+		less = l.compare.less
+	}
+
+	// Bottom-up merge sort: the sorted runs of size k are merged in pairs, doubling k until
+	// only one run is merged.
+	head = l.fnode
+	for k := 1; ; k *= 2 {
+		p, merges := head, 0
+		head, tail = nil, nil
+
+		for p != nil {
+			merges++
+
+			q, psize := p, 0
+			for ; psize < k && q != nil; psize++ {
+				q = q.next
+			}
+
+			for qsize := k; psize > 0 || (qsize > 0 && q != nil); {
+				var node *listNode
+
+				// the node of the left run goes first if the nodes are equal.
+				if psize > 0 && (qsize == 0 || q == nil || !less(q.item, p.item)) {
+					node, p = p, p.next
+					psize--
+				} else {
+					node, q = q, q.next
+					qsize--
+				}
+
+				node.prev = tail
+				if tail != nil {
+					tail.next = node
+				} else {
+					head = node
+				}
+
+				tail = node
+			}
+
+			p = q
+		}
+
+		tail.next = nil
+		if merges <= 1 {
+			break
+		}
+	}
+
+	l.fnode, l.lnode = head, tail
+	l.reorder()
+}
+
+// Reverse reverses the order of the items of the list. The internal pointer and the cursors point
+// to the same items after reversing.
+func (l *List) Reverse() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	for node := l.fnode; node != nil; node = node.prev {
+		node.prev, node.next = node.next, node.prev
+	}
+
+	l.fnode, l.lnode = l.lnode, l.fnode
+	l.reorder()
+}
+
+// Rotate rotates the items of the list k positions to the end, so the last k items are moved to
+// the begining. If k is negative, the first -k items are moved to the end. The internal pointer
+// and the cursors point to the same items after rotating.
+func (l *List) Rotate(k int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	length := l.avl.length
+	if length == 0 {
+		return
+	}
+
+	if k = (k%length + length) % length; k == 0 {
+		return
+	}
+
+	first := l.nodeAt(length - k)
+
+	l.lnode.next, l.fnode.prev = l.fnode, l.lnode
+	l.fnode, l.lnode = first, first.prev
+	l.fnode.prev, l.lnode.next = nil, nil
+
+	l.reorder()
+}
+
+// Shuffle shuffles the items of the list using the rnd random source. If rnd is nil, it uses the
+// default source of the math/rand package. The internal pointer and the cursors point to the same
+// items after shuffling.
+func (l *List) Shuffle(rnd *rand.Rand) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.fnode == nil {
+		return
+	}
+
+	nodes := make([]*listNode, 0, l.avl.length)
+	for node := l.fnode; node != nil; node = node.next {
+		nodes = append(nodes, node)
+	}
+
+	swap := func(a, b int) {
+		nodes[a], nodes[b] = nodes[b], nodes[a]
+	}
+
+	if rnd != nil {
+		rnd.Shuffle(len(nodes), swap)
+	} else {
+		rand.Shuffle(len(nodes), swap)
+	}
+
+	l.relink(nodes)
+	l.reorder()
+}
+
+// swapNodes exchanges the positions of the a and b nodes in the list.
+func (l *List) swapNodes(a, b *listNode) {
+	switch {
+	case a.next == b:
+		l.unlink(a)
+		l.link(a, b)
+
+	case b.next == a:
+		l.unlink(b)
+		l.link(b, a)
+
+	default:
+		prev := a.prev
+		l.unlink(a)
+		l.link(a, b)
+		l.unlink(b)
+		l.link(b, prev)
+	}
+}
+
+// Swap exchanges the items pointed by the a and b cursors of the list. The cursors keep their
+// positions, so each one points to the item of the other after swapping. Returns a flag
+// indicating if the items were swapped. They aren't swapped if a cursor isn't of the list or
+// doesn't point to an item.
+func (l *List) Swap(a, b *Cursor) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if a.list != l || b.list != l || !a.valid() || !b.valid() {
+		return false
+	}
+
+	if a.node == b.node {
+		return true
+	}
+
+	// the tree sorts the duplicated items by their position, so the nodes are inserted again.
+	if l.avl.duplicated {
+		l.avl.Delete(a.node)
+		l.avl.Delete(b.node)
+	}
+
+	l.swapNodes(a.node, b.node)
+
+	if l.avl.duplicated {
+		l.avl.Insert(a.node)
+		l.avl.Insert(b.node)
+	}
+
+	a.node, b.node = b.node, a.node
+	return true
+}
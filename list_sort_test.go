@@ -0,0 +1,310 @@
+package mygostructs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// checkList checks the links of the list, its positional index and its tree.
+func checkList(t *testing.T, l *List) {
+	var prev *listNode
+
+	for node := l.fnode; node != nil; node = node.next {
+		assert.True(t, node.prev == prev, "previous node of %s is incorrect", node)
+		prev = node
+	}
+
+	assert.True(t, l.lnode == prev, "last node is incorrect")
+	checkIndex(t, l)
+	checkListTree(t, l)
+}
+
+func Test_List_Sort_func(t *testing.T) {
+	as := assert.New(t)
+	list := NewList(true)
+	for _, num := range []int{5, 3, 8, 1, 3, 9, 0, 5} {
+		list.AddAfter(It(num))
+	}
+
+	c, _ := list.CursorAt(1)
+	list.Search(It(9))
+	list.Sort(nil)
+
+	as.Equal(listItems(&list), []int{0, 1, 3, 3, 5, 5, 8, 9}, "items aren't sorted")
+	checkList(t, &list)
+	checkCursor(t, c, ip(3))
+	item, _ := list.Get()
+	as.Equal(item, It(9), "internal pointer doesn't point to the same item")
+
+	list.Sort(func(a, b Item) bool {
+		return b.Less(a)
+	})
+
+	as.Equal(listItems(&list), []int{9, 8, 5, 5, 3, 3, 1, 0}, "items aren't sorted")
+	checkList(t, &list)
+
+	// the sort is stable.
+	list = NewListWith(WithDuplicates(true), WithComparator(byHundreds))
+	for _, num := range []int{301, 101, 201, 102, 302, 202, 103, 303} {
+		list.AddAfter(It(num))
+	}
+
+	list.Sort(nil)
+	as.Equal(listItems(&list), []int{101, 102, 103, 201, 202, 301, 302, 303},
+		"equal items don't keep their order")
+	checkList(t, &list)
+
+	item, _ = list.SearchLast(It(300))
+	as.Equal(item, It(303), "last item isn't found")
+
+	list.Sort(func(a, b Item) bool {
+		return a.(IntItem).value%100 < b.(IntItem).value%100
+	})
+
+	as.Equal(listItems(&list), []int{101, 201, 301, 102, 202, 302, 103, 303},
+		"items aren't sorted")
+	checkList(t, &list)
+	as.Equal(list.SearchAll(It(100)), []Item{It(101), It(102), It(103)},
+		"equal items aren't sorted by position")
+
+	empty := NewList(false)
+	empty.Sort(nil)
+	as.Equal(empty.Length(), 0, "empty list isn't empty")
+}
+
+func Test_List_Sort_func_random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, size := range []int{1, 2, 3, 7, 100, 1000} {
+		list := NewListWith(WithDuplicates(true), WithComparator(byHundreds))
+		expected := make([]int, size)
+
+		for i := range expected {
+			expected[i] = rnd.Intn(size) * 10
+			list.AddAfter(It(expected[i]))
+		}
+
+		list.Sort(nil)
+		sort.SliceStable(expected, func(a, b int) bool {
+			return expected[a]/100 < expected[b]/100
+		})
+
+		assert.Equal(t, listItems(&list), expected, "items of size %d aren't sorted", size)
+		checkList(t, &list)
+	}
+}
+
+func Test_List_Reverse_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 5)
+	c, _ := list.CursorAt(1)
+
+	list.Reverse()
+	as.Equal(listItems(list), []int{4, 3, 2, 1, 0}, "items aren't reversed")
+	checkList(t, list)
+	checkCursor(t, c, ip(1))
+
+	pos, _ := c.Index()
+	as.Equal(pos, 3, "position of the cursor is incorrect")
+
+	item, _ := list.Get()
+	as.Equal(item, It(4), "internal pointer doesn't point to the same item")
+
+	dup := NewListWith(WithDuplicates(true), WithComparator(byHundreds))
+	for _, num := range []int{101, 201, 102, 103} {
+		dup.AddAfter(It(num))
+	}
+
+	dup.Reverse()
+	checkList(t, &dup)
+	as.Equal(dup.SearchAll(It(100)), []Item{It(103), It(102), It(101)},
+		"equal items aren't sorted by position")
+
+	empty := NewList(false)
+	empty.Reverse()
+	as.Equal(empty.Length(), 0, "empty list isn't empty")
+}
+
+func Test_List_Rotate_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 5)
+
+	list.Rotate(2)
+	as.Equal(listItems(list), []int{3, 4, 0, 1, 2}, "items aren't rotated")
+	checkList(t, list)
+
+	list.Rotate(-2)
+	as.Equal(listItems(list), []int{0, 1, 2, 3, 4}, "items aren't rotated")
+	checkList(t, list)
+
+	list.Rotate(11)
+	as.Equal(listItems(list), []int{4, 0, 1, 2, 3}, "items aren't rotated")
+
+	list.Rotate(-5)
+	list.Rotate(0)
+	as.Equal(listItems(list), []int{4, 0, 1, 2, 3}, "items are rotated")
+	checkList(t, list)
+
+	item, _ := list.Get()
+	as.Equal(item, It(4), "internal pointer doesn't point to the same item")
+
+	dup := NewListWith(WithDuplicates(true), WithComparator(byHundreds))
+	for _, num := range []int{101, 201, 102, 103} {
+		dup.AddAfter(It(num))
+	}
+
+	dup.Rotate(1)
+	checkList(t, &dup)
+	as.Equal(dup.SearchAll(It(100)), []Item{It(103), It(101), It(102)},
+		"equal items aren't sorted by position")
+
+	empty := NewList(false)
+	empty.Rotate(3)
+	as.Equal(empty.Length(), 0, "empty list isn't empty")
+}
+
+func Test_List_Shuffle_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 100)
+	other := cursorList(0, 100)
+
+	list.Shuffle(rand.New(rand.NewSource(1)))
+	other.Shuffle(rand.New(rand.NewSource(1)))
+
+	values := listItems(list)
+	as.NotEqual(values, intRange(0, 100), "items aren't shuffled")
+	as.Equal(values, listItems(other), "shuffles with the same seed don't match")
+	checkList(t, list)
+
+	sort.Ints(values)
+	as.Equal(values, intRange(0, 100), "items shuffled don't match")
+
+	dup := NewList(true)
+	for i := 0; i < 100; i++ {
+		dup.AddAfter(It(i % 10))
+	}
+
+	dup.Shuffle(nil)
+	checkList(t, &dup)
+	as.Equal(dup.CountOf(It(3)), 10, "count is incorrect")
+
+	empty := NewList(false)
+	empty.Shuffle(nil)
+	as.Equal(empty.Length(), 0, "empty list isn't empty")
+}
+
+func Test_List_Swap_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 6)
+	a, _ := list.CursorAt(0)
+	b, _ := list.CursorAt(5)
+
+	as.True(list.Swap(a, b), "items weren't swapped")
+	as.Equal(listItems(list), []int{5, 1, 2, 3, 4, 0}, "items don't match")
+	checkCursor(t, a, ip(5))
+	checkCursor(t, b, ip(0))
+	checkList(t, list)
+
+	// adjacent items, in both orders.
+	a, _ = list.CursorAt(1)
+	b, _ = list.CursorAt(2)
+	as.True(list.Swap(a, b), "items weren't swapped")
+	as.Equal(listItems(list), []int{5, 2, 1, 3, 4, 0}, "items don't match")
+	checkList(t, list)
+
+	as.True(list.Swap(b, a), "items weren't swapped")
+	as.Equal(listItems(list), []int{5, 1, 2, 3, 4, 0}, "items don't match")
+	checkCursor(t, a, ip(1))
+	checkList(t, list)
+
+	as.True(list.Swap(a, a), "item wasn't swapped with itself")
+
+	// the cursors aren't valid.
+	other := cursorList(0, 3)
+	as.False(list.Swap(a, other.Cursor()), "item was swapped with another list")
+
+	list.Search(It(2))
+	list.Delete()
+	c, _ := list.CursorAt(0)
+	list.Search(It(1))
+	list.Delete()
+	as.False(list.Swap(a, c), "detached cursor swapped an item")
+
+	// the duplicated items are sorted again in the tree.
+	dup := NewListWith(WithDuplicates(true), WithComparator(byHundreds))
+	for _, num := range []int{101, 201, 102, 103} {
+		dup.AddAfter(It(num))
+	}
+
+	a, _ = dup.CursorAt(0)
+	b, _ = dup.CursorAt(3)
+	dup.Swap(a, b)
+	checkList(t, &dup)
+	as.Equal(dup.SearchAll(It(100)), []Item{It(103), It(102), It(101)},
+		"equal items aren't sorted by position")
+}
+
+func Test_List_Swap_func_random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	list := NewList(true)
+	expected := []int{}
+
+	for i := 0; i < 200; i++ {
+		list.AddAfter(It(i % 20))
+		expected = append(expected, i%20)
+	}
+
+	for i := 0; i < 500; i++ {
+		x, y := rnd.Intn(200), rnd.Intn(200)
+		a, _ := list.CursorAt(x)
+		b, _ := list.CursorAt(y)
+
+		list.Swap(a, b)
+		expected[x], expected[y] = expected[y], expected[x]
+	}
+
+	assert.Equal(t, listItems(&list), expected, "items don't match")
+	checkList(t, &list)
+}
+
+func Test_List_Sort_func_sync(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 1000)
+	concurrence := 4
+	done := make(chan bool)
+
+	// the readers always see all items while the list is reordered.
+	read := func() {
+		for i := 0; i < 100; i++ {
+			as.Equal(list.CountOf(It(i)), 1, "item %d not found", i)
+			_, found := list.At(999)
+			as.True(found, "last item not found")
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go read()
+	}
+
+	go func() {
+		for i := 0; i < 20; i++ {
+			list.Shuffle(nil)
+			list.Rotate(i)
+			list.Reverse()
+			list.Sort(nil)
+		}
+
+		done <- true
+	}()
+
+	for i := 0; i <= concurrence; i++ {
+		<-done
+	}
+
+	as.Equal(listItems(list), intRange(0, 1000), "items aren't sorted")
+	checkList(t, list)
+}
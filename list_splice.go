@@ -0,0 +1,190 @@
+package mygostructs
+
+// linkChain links the chain of nodes from the first node to the last node after the prev node, or
+// at the begining if prev is nil, and inserts them in the positional index. The nodes are owned
+// by the list, but they aren't inserted in the tree.
+func (l *List) linkChain(first, last, prev *listNode) {
+	if l.owner == nil {
+		l.owner = &listOwner{}
+	}
+
+	first.prev = prev
+	if prev != nil {
+		last.next = prev.next
+		prev.next = first
+	} else {
+		last.next = l.fnode
+		l.fnode = first
+	}
+
+	if last.next != nil {
+		last.next.prev = last
+	} else {
+		l.lnode = last
+	}
+
+	for node := first; node != last.next; node = node.next {
+		node.owner = l.owner
+		l.indexInsert(node)
+	}
+}
+
+// unlinkChain unlinks the chain of nodes from the first node to the last node of the list and
+// deletes them of the positional index. The nodes of the chain keep their links between them, but
+// they aren't deleted of the tree.
+func (l *List) unlinkChain(first, last *listNode) {
+	for node := first; node != last.next; node = node.next {
+		l.indexRemove(node)
+	}
+
+	if first.prev != nil {
+		first.prev.next = last.next
+	} else {
+		l.fnode = last.next
+	}
+
+	if last.next != nil {
+		last.next.prev = first.prev
+	} else {
+		l.lnode = first.prev
+	}
+
+	first.prev, last.next = nil, nil
+}
+
+// moveFrom moves all nodes of the other list after the prev node of the l list, or at the
+// begining if prev is nil. The other list is empty after the operation. Returns a flag indicating
+// if the nodes were moved. They aren't moved if the l list doesn't have capacity for them or an
+// item would be duplicated and the l list doesn't allow it. Then both lists don't change. The
+// lists must be locked.
+func (l *List) moveFrom(other *List, prev *listNode) bool {
+	first, last := other.fnode, other.lnode
+	if first == nil {
+		return true
+	}
+
+	if l.avl.capacity > 0 && l.avl.length+other.avl.length > l.avl.capacity {
+		return false
+	}
+
+	// the nodes are linked before inserting them in the tree, because the tree compares the
+	// duplicated items by their position.
+	l.linkChain(first, last, prev)
+
+	for node := first; node != last.next; node = node.next {
+		if l.avl.Insert(node) {
+			continue
+		}
+
+		// the item is duplicated, so the nodes are returned to the other list.
+		for inserted := first; inserted != node; inserted = inserted.next {
+			l.avl.Delete(inserted)
+		}
+
+		l.unlinkChain(first, last)
+		for moved := first; moved != nil; moved = moved.next {
+			moved.owner = other.owner
+		}
+
+		other.rebuildIndex()
+		return false
+	}
+
+	if l.pnode == nil {
+		l.pnode = first
+	}
+
+	other.avl = other.avl.emptyCopy()
+	other.owner = nil
+	other.index = nil
+	other.fnode = nil
+	other.pnode = nil
+	other.lnode = nil
+	return true
+}
+
+// Concat moves all items of the other list to the end of the l list, without copying them. The
+// other list is empty after the operation. Returns a flag indicating if the items were moved. They
+// aren't moved if other is the l list, the l list doesn't have capacity for them, or an item
+// would be duplicated and the l list doesn't allow it. Then both lists don't change. The items are
+// compared with the comparator of the l list.
+func (l *List) Concat(other *List) bool {
+	if l == other {
+		return false
+	}
+
+	unlock := lockPair(&l.mutex, &other.mutex, false)
+	defer unlock()
+
+	return l.moveFrom(other, l.lnode)
+}
+
+// Splice moves all items of the other list after the item pointed by the c cursor, as the Concat
+// function. The cursor doesn't move, except if the l list is empty, then the items are the only
+// items of the list and the cursor points to the first of them. The items aren't moved if the
+// cursor isn't of the l list or the item pointed was deleted.
+func (l *List) Splice(c *Cursor, other *List) bool {
+	if l == other || c.list != l {
+		return false
+	}
+
+	unlock := lockPair(&l.mutex, &other.mutex, false)
+	defer unlock()
+
+	if l.fnode == nil {
+		c.node = nil
+	} else if !c.valid() {
+		return false
+	}
+
+	if !l.moveFrom(other, c.node) {
+		return false
+	}
+
+	if c.node == nil {
+		c.node = l.fnode
+	}
+
+	return true
+}
+
+// Sublist moves the items from the from position to the to position, not included, to a new list
+// with the settings of the l list, and returns it. The second value returned is false if the
+// positions don't exist. The cursors that point to the items moved are detached. If the internal
+// pointer points to an item moved, it is moved to the begining of the list, as in the Delete
+// function. The internal pointer of the new list points to its first item.
+func (l *List) Sublist(from, to int) (*List, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if from < 0 || to > l.avl.length || from > to {
+		return nil, false
+	}
+
+	sub := l.emptyCopy()
+	if from == to {
+		return &sub, true
+	}
+
+	first, last := l.nodeAt(from), l.nodeAt(to-1)
+	for node := first; node != last.next; node = node.next {
+		l.avl.Delete(node)
+
+		if l.pnode == node {
+			l.pnode = nil
+		}
+	}
+
+	l.unlinkChain(first, last)
+	if l.pnode == nil {
+		l.pnode = l.fnode
+	}
+
+	sub.linkChain(first, last, nil)
+	for node := first; node != nil; node = node.next {
+		sub.avl.Insert(node)
+	}
+
+	sub.pnode = first
+	return &sub, true
+}
@@ -0,0 +1,217 @@
+package mygostructs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_List_Concat_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 3)
+	other := cursorList(3, 6)
+	c := other.Cursor()
+
+	as.True(list.Concat(other), "lists weren't concatenated")
+	as.Equal(listItems(list), []int{0, 1, 2, 3, 4, 5}, "items don't match")
+	as.Equal(list.Length(), 6, "length doesn't match")
+	checkList(t, list)
+
+	as.Equal(other.Length(), 0, "other list isn't empty")
+	as.Empty(listItems(other), "other list has items")
+	checkList(t, other)
+	checkCursor(t, c, nil)
+
+	_, found := list.Search(It(4))
+	as.True(found, "item moved not found")
+
+	// the internal pointer of an empty list points to the first item.
+	empty := NewList(false)
+	as.True(empty.Concat(list), "lists weren't concatenated")
+	item, _ := empty.Get()
+	as.Equal(item, It(0), "internal pointer doesn't point to the first item")
+	as.True(empty.Concat(list), "empty list wasn't concatenated")
+	as.False(empty.Concat(&empty), "list was concatenated with itself")
+
+	// the duplicated items keep the order of the list.
+	dup := NewListWith(WithDuplicates(true), WithComparator(byHundreds))
+	dupOther := NewListWith(WithDuplicates(true), WithComparator(byHundreds))
+	for _, num := range []int{101, 201, 102} {
+		dup.AddAfter(It(num))
+		dupOther.AddAfter(It(num + 10))
+	}
+
+	as.True(dup.Concat(&dupOther), "lists weren't concatenated")
+	checkList(t, &dup)
+	as.Equal(dup.SearchAll(It(100)), []Item{It(101), It(102), It(111), It(112)},
+		"equal items aren't sorted by position")
+}
+
+func Test_List_Concat_func_fail(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 3)
+	other := cursorList(3, 6)
+	other.AddAfter(It(1))
+
+	// the item 1 is duplicated.
+	as.False(list.Concat(other), "duplicated item was concatenated")
+	as.Equal(listItems(list), []int{0, 1, 2}, "items of the list changed")
+	as.Equal(listItems(other), []int{3, 4, 5, 1}, "items of the other list changed")
+	checkList(t, list)
+	checkList(t, other)
+
+	c, _ := other.CursorAt(0)
+	checkCursor(t, c, ip(3))
+
+	// the other list has duplicated items.
+	dup := NewList(true)
+	dup.AddAfter(It(10))
+	dup.AddAfter(It(10))
+	as.False(list.Concat(&dup), "duplicated item was concatenated")
+	as.Equal(dup.Length(), 2, "other list changed")
+	checkList(t, &dup)
+	checkList(t, list)
+
+	// the list is full.
+	full := NewListWith(WithCapacity(3))
+	full.AddAfter(It(10))
+	as.False(full.Concat(list), "list without capacity was concatenated")
+	as.Equal(list.Length(), 3, "other list changed")
+	as.Equal(listItems(&full), []int{10}, "items of the list changed")
+}
+
+func Test_List_Splice_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 3)
+	c, _ := list.CursorAt(1)
+
+	as.True(list.Splice(c, cursorList(10, 13)), "items weren't spliced")
+	as.Equal(listItems(list), []int{0, 1, 10, 11, 12, 2}, "items don't match")
+	checkCursor(t, c, ip(1))
+	checkList(t, list)
+
+	c.Last()
+	as.True(list.Splice(c, cursorList(20, 22)), "items weren't spliced")
+	as.Equal(listItems(list), []int{0, 1, 10, 11, 12, 2, 20, 21}, "items don't match")
+	checkList(t, list)
+
+	// the cursor of an empty list points to the first item.
+	empty := NewList(false)
+	c = empty.Cursor()
+	as.True(empty.Splice(c, cursorList(0, 2)), "items weren't spliced")
+	checkCursor(t, c, ip(0))
+	as.Equal(listItems(&empty), []int{0, 1}, "items don't match")
+
+	// the cursor isn't valid.
+	other := cursorList(30, 32)
+	as.False(list.Splice(empty.Cursor(), other), "items were spliced with cursor of other list")
+	as.False(list.Splice(list.Cursor(), list), "list was spliced in itself")
+
+	c, _ = list.CursorAt(0)
+	list.RemoveAt(0)
+	as.False(list.Splice(c, other), "items were spliced after a deleted item")
+
+	// the item 2 is duplicated.
+	c, _ = list.CursorAt(0)
+	other.AddAfter(It(2))
+	as.False(list.Splice(c, other), "duplicated item was spliced")
+	as.Equal(listItems(other), []int{30, 31, 2}, "items of the other list changed")
+	checkList(t, list)
+	checkList(t, other)
+}
+
+func Test_List_Sublist_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 6)
+	c, _ := list.CursorAt(2)
+	list.Search(It(3))
+
+	sub, ok := list.Sublist(1, 4)
+	as.True(ok, "sublist wasn't created")
+	as.Equal(listItems(list), []int{0, 4, 5}, "items of the list don't match")
+	as.Equal(listItems(sub), []int{1, 2, 3}, "items of the sublist don't match")
+	checkList(t, list)
+	checkList(t, sub)
+
+	checkCursor(t, c, nil)
+	item, _ := list.Get()
+	as.Equal(item, It(0), "internal pointer wasn't moved to the begining")
+	item, _ = sub.Get()
+	as.Equal(item, It(1), "internal pointer of the sublist isn't the first item")
+
+	_, found := list.Search(It(2))
+	as.False(found, "item moved found in the list")
+	_, found = sub.Search(It(2))
+	as.True(found, "item moved not found in the sublist")
+
+	// the sublist has the settings of the list.
+	as.False(sub.AddAfter(It(1)), "sublist allows duplicated items")
+
+	unsync := NewListUnsync(false)
+	unsync.AddAfter(It(1))
+	sub, _ = unsync.Sublist(0, 1)
+	as.True(sub.mutex.disabled, "sublist is locked")
+	as.Equal(unsync.Length(), 0, "list isn't empty")
+	checkList(t, &unsync)
+
+	sub, ok = list.Sublist(3, 3)
+	as.True(ok, "empty sublist wasn't created")
+	as.Equal(sub.Length(), 0, "sublist isn't empty")
+
+	for _, r := range [][2]int{{-1, 2}, {0, 4}, {2, 1}} {
+		sub, ok = list.Sublist(r[0], r[1])
+		as.False(ok, "sublist from %d to %d was created", r[0], r[1])
+		as.Nil(sub, "sublist isn't nil")
+	}
+
+	// the duplicated items keep the order of the list.
+	dup := NewListWith(WithDuplicates(true), WithComparator(byHundreds))
+	for _, num := range []int{101, 201, 102, 103, 202} {
+		dup.AddAfter(It(num))
+	}
+
+	sub, _ = dup.Sublist(1, 4)
+	checkList(t, &dup)
+	checkList(t, sub)
+	as.Equal(sub.SearchAll(It(100)), []Item{It(102), It(103)}, "items found don't match")
+	as.Equal(dup.SearchAll(It(200)), []Item{It(202)}, "items found don't match")
+}
+
+func Test_List_Concat_func_sync(t *testing.T) {
+	as := assert.New(t)
+	a, b := cursorList(0, 100), cursorList(100, 200)
+	concurrence := 4
+	done := make(chan bool)
+
+	// the lists are concatenated in both directions at the same time, so the locks are taken in
+	// the same order for avoid deadlocks.
+	move := func(dst, src *List) {
+		for i := 0; i < 100; i++ {
+			dst.Concat(src)
+			if sub, ok := dst.Sublist(0, dst.Length()/2); ok {
+				src.Concat(sub)
+			}
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < concurrence; i++ {
+		if i%2 == 0 {
+			go move(a, b)
+		} else {
+			go move(b, a)
+		}
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+	}
+
+	as.Equal(a.Length()+b.Length(), 200, "items were lost")
+	checkList(t, a)
+	checkList(t, b)
+
+	a.Concat(b)
+	a.Sort(nil)
+	as.Equal(listItems(a), intRange(0, 200), "items don't match")
+}
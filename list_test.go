@@ -56,29 +56,44 @@ func changeListProperties(l *List, max int, done chan bool) {
 func Test_listNode_Less_func(t *testing.T) {
 	var n1, n2 listNode
 	as := assert.New(t)
-	n1 = listNode{nil, nil, It(1)}
+	n1 = listNode{item: It(1)}
 
-	n2 = listNode{nil, nil, It(2)}
+	n2 = listNode{item: It(2)}
 	as.True(n1.Less(&n2), "%s isnt't less than %s", n1, n2)
-	n2 = listNode{nil, nil, It(1)}
+	n2 = listNode{item: It(1)}
 	as.False(n1.Less(&n2), "%s is less than %s", n1, n2)
-	n2 = listNode{nil, nil, It(0)}
+	n2 = listNode{item: It(0)}
 	as.False(n1.Less(&n2), "%s is less than %s", n1, n2)
 }
 
 func Test_listNode_Eq_func(t *testing.T) {
 	var n1, n2 listNode
 	as := assert.New(t)
-	n1 = listNode{nil, nil, It(1)}
+	n1 = listNode{item: It(1)}
 
-	n2 = listNode{nil, nil, It(1)}
+	n2 = listNode{item: It(1)}
 	as.True(n1.Eq(&n2), "%s isn't equal to %s", n1, n2)
-	n2 = listNode{nil, nil, It(0)}
+	n2 = listNode{item: It(0)}
 	as.False(n1.Eq(&n2), "%s is equal to %s", n1, n2)
 }
 
+func Test_listNode_Compare_func(t *testing.T) {
+	as := assert.New(t)
+	n1 := listNode{item: It(1)}
+
+	as.Negative(n1.Compare(&listNode{item: It(2)}), "1 isn't less than 2")
+	as.Zero(n1.Compare(&listNode{item: It(1)}), "1 isn't equal to 1")
+	as.Positive(n1.Compare(&listNode{item: It(0)}), "1 isn't greater than 0")
+
+	// the items without Compare function use Less and Eq.
+	n2 := listNode{item: testItem{1}}
+	as.Negative(n2.Compare(&listNode{item: testItem{2}}), "1 isn't less than 2")
+	as.Zero(n2.Compare(&listNode{item: testItem{1}}), "1 isn't equal to 1")
+	as.Positive(n1.Compare(It(0)), "node is compared with an item")
+}
+
 func Test_listNode_String_func(t *testing.T) {
-	assert.Equalf(t, listNode{nil, nil, It(1)}.String(), "1", "item stringify is invalid")
+	assert.Equalf(t, listNode{item: It(1)}.String(), "1", "item stringify is invalid")
 }
 
 func Test_NewList_func(t *testing.T) {
@@ -97,6 +112,39 @@ func Test_NewList_func(t *testing.T) {
 	}
 }
 
+func Test_NewListWith_func(t *testing.T) {
+	as := assert.New(t)
+	list := NewListWith(WithComparator(lastDigit), WithCapacity(3), WithLock(false))
+
+	as.True(list.mutex.disabled, "list lock isn't disabled")
+	as.True(list.avl.mutex.disabled, "avl lock isn't disabled")
+
+	as.True(list.AddAfter(It(1)), "item wasn't added")
+	as.False(list.AddAfter(It(11)), "duplicated item was added")
+	as.True(list.AddBefore(It(2)), "item wasn't added")
+	as.True(list.AddAfter(It(3)), "item wasn't added")
+	as.False(list.AddAfter(It(4)), "item was added in full list")
+
+	item, found := list.Search(It(12))
+	as.True(found, "item not found")
+	as.Equal(item, It(2), "item found is incorrect")
+
+	// the new lists use the settings of the list.
+	mapped := list.Map(func(it Item) Item { return It(it.(IntItem).value + 10) })
+	_, found = mapped.Search(It(1))
+	as.True(found, "item not found in mapped list")
+	as.False(mapped.AddAfter(It(5)), "item was added in full list")
+
+	list.Clear()
+	as.True(list.AddAfter(It(1)), "item wasn't added")
+	as.False(list.AddAfter(It(21)), "duplicated item was added after clear")
+
+	list = NewListWith(WithDuplicates(true))
+	as.True(list.AddAfter(It(1)), "item wasn't added")
+	as.True(list.AddAfter(It(1)), "duplicated item wasn't added")
+	as.False(list.mutex.disabled, "list lock is disabled")
+}
+
 func Test_List_AddAfter_func(t *testing.T) {
 	var (
 		inserted bool
@@ -112,7 +160,7 @@ func Test_List_AddAfter_func(t *testing.T) {
 		item := It(i)
 		inserted = list.AddAfter(item)
 		as.True(inserted, "item %s no inserted", item)
-		_, found := list.avl.Search(&listNode{nil, nil, item})
+		_, found := list.avl.Search(&listNode{item: item})
 		as.True(found, "item %s not found in the AVL tree", item)
 	}
 	as.Equal(list.Length(), size, "list length is invalid")
@@ -176,7 +224,7 @@ func Test_List_AddAfter_func_sync(t *testing.T) {
 	as.Equal(list.avl.Length(), concurrence*size, "list length doesn't match")
 	for i := 0; i < concurrence*size; i++ {
 		item := It(i)
-		_, found := list.avl.Search(&listNode{nil, nil, item})
+		_, found := list.avl.Search(&listNode{item: item})
 		as.Truef(found, "item %s not found", item)
 	}
 }
@@ -196,7 +244,7 @@ func Test_List_AddBefore_func(t *testing.T) {
 		item := It(i)
 		inserted = list.AddBefore(item)
 		as.True(inserted, "item %s no inserted", item)
-		_, found := list.avl.Search(&listNode{nil, nil, item})
+		_, found := list.avl.Search(&listNode{item: item})
 		as.True(found, "item %s not found in the AVL tree", item)
 	}
 	as.Equal(list.Length(), size, "list length is invalid")
@@ -261,7 +309,7 @@ func Test_List_AddBefore_func_sync(t *testing.T) {
 	as.Equal(list.avl.Length(), concurrence*size, "list length doesn't match")
 	for i := 0; i < concurrence*size; i++ {
 		item := It(i)
-		_, found := list.avl.Search(&listNode{nil, nil, item})
+		_, found := list.avl.Search(&listNode{item: item})
 		as.True(found, "item %s not found", item)
 	}
 }
@@ -963,6 +1011,138 @@ func Test_List_Clear_func(t *testing.T) {
 	}
 }
 
+func Test_List_At_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 100)
+
+	for pos := 0; pos < 100; pos++ {
+		item, found := list.At(pos)
+		as.True(found, "position %d not found", pos)
+		as.Equal(item, It(pos), "item at %d is incorrect", pos)
+	}
+
+	for _, pos := range []int{-1, 100} {
+		item, found := list.At(pos)
+		as.False(found, "position %d found", pos)
+		as.Nil(item, "item isn't nil")
+	}
+
+	item, _ := list.Get()
+	as.Equal(item, It(99), "internal pointer moved")
+}
+
+func Test_List_InsertAt_func(t *testing.T) {
+	as := assert.New(t)
+	list := NewListWith(WithCapacity(5))
+
+	as.True(list.InsertAt(0, It(2)), "item wasn't inserted in empty list")
+	as.True(list.InsertAt(0, It(0)), "item wasn't inserted at the begining")
+	as.True(list.InsertAt(2, It(4)), "item wasn't inserted at the end")
+	as.True(list.InsertAt(1, It(1)), "item wasn't inserted")
+	as.False(list.InsertAt(1, It(1)), "duplicated item was inserted")
+	as.False(list.InsertAt(5, It(5)), "item was inserted out of range")
+	as.False(list.InsertAt(-1, It(5)), "item was inserted out of range")
+	as.True(list.InsertAt(3, It(3)), "item wasn't inserted")
+	as.False(list.InsertAt(5, It(5)), "item was inserted in full list")
+
+	as.Equal(listItems(&list), []int{0, 1, 2, 3, 4}, "items don't match")
+	checkln(t, list.fnode, 0, nil, ip(1))
+	checkln(t, list.lnode, 4, ip(3), nil)
+	checkIndex(t, &list)
+
+	item, _ := list.Get()
+	as.Equal(item, It(2), "internal pointer doesn't point to the first item inserted")
+
+	_, found := list.Search(It(3))
+	as.True(found, "item inserted not found")
+}
+
+func Test_List_RemoveAt_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 5)
+
+	item, removed := list.RemoveAt(2)
+	as.True(removed, "item wasn't removed")
+	as.Equal(item, It(2), "item removed is incorrect")
+
+	_, removed = list.RemoveAt(4)
+	as.False(removed, "item removed out of range")
+
+	// the internal pointer points to the last item.
+	item, _ = list.RemoveAt(3)
+	as.Equal(item, It(4), "item removed is incorrect")
+	item, _ = list.Get()
+	as.Equal(item, It(0), "internal pointer wasn't moved to the begining")
+
+	as.Equal(listItems(list), []int{0, 1, 3}, "items don't match")
+	checkIndex(t, list)
+
+	_, found := list.Search(It(2))
+	as.False(found, "item removed found")
+}
+
+func Test_List_IndexOf_func(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 100)
+
+	for num := 0; num < 100; num++ {
+		pos, found := list.IndexOf(It(num))
+		as.True(found, "item %d not found", num)
+		as.Equal(pos, num, "position of %d is incorrect", num)
+	}
+
+	pos, found := list.IndexOf(It(100))
+	as.False(found, "item found")
+	as.Equal(pos, -1, "position of item not found isn't -1")
+
+	list.RemoveAt(0)
+	pos, _ = list.IndexOf(It(50))
+	as.Equal(pos, 49, "position isn't updated")
+
+	item, _ := list.Get()
+	as.Equal(item, It(99), "internal pointer moved")
+}
+
+func Test_List_At_func_sync(t *testing.T) {
+	as := assert.New(t)
+	list := cursorList(0, 1000)
+	concurrence := 4
+	done := make(chan bool)
+
+	// the readers see the even items while a writer inserts and removes the odd items.
+	read := func() {
+		for i := 0; i < 1000; i++ {
+			item, found := list.At(0)
+			as.True(found, "first item not found")
+			as.Equal(item, It(0), "first item is incorrect")
+
+			pos, _ := list.IndexOf(It(0))
+			as.Equal(pos, 0, "position of first item is incorrect")
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go read()
+	}
+
+	go func() {
+		for i := 0; i < 1000; i++ {
+			list.InsertAt(1, It(-i-1))
+			list.RemoveAt(1)
+		}
+
+		done <- true
+	}()
+
+	for i := 0; i <= concurrence; i++ {
+		<-done
+	}
+
+	as.Equal(listItems(list), intRange(0, 1000), "items don't match")
+}
+
 func Test_List_ForEach_func(t *testing.T) {
 	as := assert.New(t)
 	list := NewList(true)
@@ -1110,3 +1290,186 @@ func Test_List_Filter_func(t *testing.T) {
 		"item pointed by internal pointer is diff after execute filter function",
 	)
 }
+
+func Test_List_reads_shared(t *testing.T) {
+	list := NewList(false)
+	for num := 0; num < 5; num++ {
+		list.AddAfter(It(num))
+	}
+
+	checkSharedRead(t, &list.mutex, "Get", func() { list.Get() })
+	checkSharedRead(t, &list.mutex, "Length", func() { list.Length() })
+	checkSharedRead(t, &list.mutex, "ForEach", func() { list.ForEach(func(Item) {}) })
+	checkSharedRead(t, &list.mutex, "Map", func() {
+		list.Map(func(it Item) Item { return it })
+	})
+	checkSharedRead(t, &list.mutex, "Filter", func() {
+		list.Filter(func(it Item) bool { return true })
+	})
+}
+
+// byHundreds compares the int items by their hundreds, so the items with the same hundreds are
+// equal, but they can be distinguished.
+func byHundreds(a, b Item) int {
+	return a.(IntItem).value/100 - b.(IntItem).value/100
+}
+
+// checkListTree checks that the avl tree of the list contains the nodes of the list, and that the
+// equal items are sorted in the tree as in the list.
+func checkListTree(t *testing.T, l *List) {
+	nodes := []*listNode{}
+	l.avl.Traverse(InOrder, func(it Item) bool {
+		nodes = append(nodes, it.(*listNode))
+		return true
+	})
+
+	for _, node := range nodes {
+		assert.True(t, l.contains(node), "node %s of the tree isn't in the list", node)
+	}
+
+	assert.Equal(t, len(nodes), l.index.indexSize(), "tree length doesn't match")
+	for i := 1; i < len(nodes); i++ {
+		if l.compare.compare(nodes[i-1].item, nodes[i].item) == 0 {
+			assert.Less(t, indexOf(nodes[i-1]), indexOf(nodes[i]),
+				"equal items %s and %s aren't sorted", nodes[i-1], nodes[i])
+		}
+	}
+}
+
+func Test_List_duplicates_func(t *testing.T) {
+	as := assert.New(t)
+	list := NewListWith(WithDuplicates(true), WithComparator(byHundreds))
+
+	for _, num := range []int{101, 200, 102, 300, 103} {
+		list.AddAfter(It(num))
+	}
+
+	list.InsertAt(0, It(104))
+	list.First()
+	list.Next()
+	list.AddBefore(It(105))
+	as.Equal(listItems(&list), []int{104, 105, 101, 200, 102, 300, 103}, "items don't match")
+	checkListTree(t, &list)
+
+	item, found := list.Search(It(100))
+	as.True(found, "item not found")
+	as.Equal(item, It(104), "item found isn't the first")
+
+	item, found = list.SearchLast(It(100))
+	as.True(found, "item not found")
+	as.Equal(item, It(103), "item found isn't the last")
+	item, _ = list.Get()
+	as.Equal(item, It(103), "internal pointer wasn't moved")
+
+	pos, _ := list.IndexOf(It(100))
+	as.Equal(pos, 0, "position of the first item is incorrect")
+	pos, _ = list.LastIndexOf(It(100))
+	as.Equal(pos, 6, "position of the last item is incorrect")
+	pos, found = list.LastIndexOf(It(400))
+	as.False(found, "item found")
+	as.Equal(pos, -1, "position of item not found isn't -1")
+
+	_, found = list.SearchLast(It(400))
+	as.False(found, "item found")
+
+	as.Equal(list.SearchAll(It(100)), []Item{It(104), It(105), It(101), It(102), It(103)},
+		"items found don't match")
+	as.Equal(list.SearchAll(It(300)), []Item{It(300)}, "items found don't match")
+	as.Empty(list.SearchAll(It(400)), "items found")
+
+	as.Equal(list.CountOf(It(100)), 5, "count is incorrect")
+	as.Equal(list.CountOf(It(200)), 1, "count is incorrect")
+	as.Equal(list.CountOf(It(400)), 0, "count is incorrect")
+
+	// the item pointed by the internal pointer is deleted, not another equal item.
+	list.Search(It(100))
+	list.Next()
+	item, _ = list.Delete()
+	as.Equal(item, It(105), "item deleted is incorrect")
+	as.Equal(list.SearchAll(It(100)), []Item{It(104), It(101), It(102), It(103)},
+		"items found don't match")
+	checkListTree(t, &list)
+
+	// the item replaced is sorted by its position.
+	c, _ := list.CursorAt(2)
+	c.Replace(It(199))
+	checkListTree(t, &list)
+	as.Equal(list.SearchAll(It(100)), []Item{It(104), It(101), It(199), It(102), It(103)},
+		"items found don't match")
+
+	list.Last()
+	as.Equal(list.DeleteAll(It(100)), 5, "number of items deleted is incorrect")
+	as.Equal(listItems(&list), []int{300}, "items don't match")
+	as.Equal(list.DeleteAll(It(100)), 0, "number of items deleted is incorrect")
+	checkListTree(t, &list)
+	checkIndex(t, &list)
+
+	item, _ = list.Get()
+	as.Equal(item, It(300), "internal pointer wasn't moved to the begining")
+
+	// the list without duplicated items.
+	unique := cursorList(0, 5)
+	as.Equal(unique.SearchAll(It(2)), []Item{It(2)}, "items found don't match")
+	as.Equal(unique.CountOf(It(2)), 1, "count is incorrect")
+	as.Equal(unique.DeleteAll(It(2)), 1, "number of items deleted is incorrect")
+	as.Equal(unique.CountOf(It(2)), 0, "count is incorrect")
+	pos, _ = unique.LastIndexOf(It(3))
+	as.Equal(pos, 2, "position is incorrect")
+}
+
+func Test_List_duplicates_func_random(t *testing.T) {
+	list := NewList(true)
+
+	for i := 0; i < 1000; i++ {
+		switch {
+		case i%3 == 2:
+			list.RemoveAt(i * 7919 % list.Length())
+		case i%2 == 0:
+			list.InsertAt(i*31%(list.Length()+1), It(i%10))
+		default:
+			list.AddAfter(It(i % 10))
+		}
+	}
+
+	checkListTree(t, &list)
+	checkIndex(t, &list)
+
+	for num := 0; num < 10; num++ {
+		count := 0
+		for _, value := range listItems(&list) {
+			if value == num {
+				count++
+			}
+		}
+
+		assert.Equal(t, list.CountOf(It(num)), count, "count of %d is incorrect", num)
+		assert.Len(t, list.SearchAll(It(num)), count, "items found don't match")
+	}
+}
+
+func Test_List_DeleteAll_func_sync(t *testing.T) {
+	as := assert.New(t)
+	list := NewList(true)
+	concurrence := 4
+	done := make(chan bool)
+
+	for i := 0; i < 1000; i++ {
+		list.AddAfter(It(i % concurrence))
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go func(num int) {
+			as.Equal(list.CountOf(It(num)), 250, "count is incorrect")
+			deleted := list.DeleteAll(It(num))
+			as.Equal(deleted, 250, "number of items deleted is incorrect")
+			done <- true
+		}(i)
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+	}
+
+	as.Equal(list.Length(), 0, "list isn't empty")
+	checkListTree(t, &list)
+}
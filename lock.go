@@ -0,0 +1,75 @@
+package mygostructs
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// rwLock is the reader/writer lock used by the structs. The lock can be disabled, for the structs
+// that are used only by one goroutine and don't need synchronization. The zero value is an enabled
+// lock.
+type rwLock struct {
+	sync.RWMutex
+	disabled bool // Flag indicating if the lock is disabled.
+}
+
+// Lock locks the lock for writing, if it is enabled.
+func (l *rwLock) Lock() {
+	if !l.disabled {
+		l.RWMutex.Lock()
+	}
+}
+
+// Unlock unlocks the lock for writing, if it is enabled.
+func (l *rwLock) Unlock() {
+	if !l.disabled {
+		l.RWMutex.Unlock()
+	}
+}
+
+// RLock locks the lock for reading, if it is enabled.
+func (l *rwLock) RLock() {
+	if !l.disabled {
+		l.RWMutex.RLock()
+	}
+}
+
+// RUnlock unlocks the lock for reading, if it is enabled.
+func (l *rwLock) RUnlock() {
+	if !l.disabled {
+		l.RWMutex.RUnlock()
+	}
+}
+
+// lockMutex locks the mutex and returns the function that unlocks it. If the shared flag is true,
+// the mutex is locked for reading.
+func lockMutex(mutex *rwLock, shared bool) func() {
+	if shared {
+		mutex.RLock()
+		return mutex.RUnlock
+	}
+
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+// lockPair locks the a and b mutexes, always in the same order for avoid deadlocks, and returns a
+// function that unlocks them. If the shared flag is true, the mutexes are locked for reading. If a
+// and b are the same mutex, it is locked once.
+func lockPair(a, b *rwLock, shared bool) func() {
+	if a == b {
+		return lockMutex(a, shared)
+	}
+
+	if uintptr(unsafe.Pointer(a)) > uintptr(unsafe.Pointer(b)) {
+		a, b = b, a
+	}
+
+	unlockA := lockMutex(a, shared)
+	unlockB := lockMutex(b, shared)
+
+	return func() {
+		unlockB()
+		unlockA()
+	}
+}
@@ -0,0 +1,131 @@
+package mygostructs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_rwLock_disabled(t *testing.T) {
+	as := assert.New(t)
+	lock := rwLock{disabled: true}
+
+	// the disabled lock never waits.
+	lock.Lock()
+	lock.Lock()
+	lock.RLock()
+	as.True(lock.RWMutex.TryLock(), "the mutex is locked")
+	lock.RWMutex.Unlock()
+
+	lock.Unlock()
+	lock.Unlock()
+	lock.RUnlock()
+
+	lock = rwLock{}
+	lock.RLock()
+	as.False(lock.TryLock(), "the mutex isn't locked")
+	lock.RUnlock()
+	as.True(lock.TryLock(), "the mutex is locked")
+	lock.Unlock()
+}
+
+func Test_lockPair_func(t *testing.T) {
+	var a, b rwLock
+
+	for _, shared := range []bool{false, true} {
+		unlock := lockPair(&a, &b, shared)
+		assert.False(t, a.TryLock(), "a mutex isn't locked")
+		assert.False(t, b.TryLock(), "b mutex isn't locked")
+		assert.Equal(t, a.TryRLock(), shared, "a mutex read lock doesn't match")
+		assert.Equal(t, b.TryRLock(), shared, "b mutex read lock doesn't match")
+
+		if shared {
+			a.RUnlock()
+			b.RUnlock()
+		}
+
+		unlock()
+		assert.True(t, a.TryLock(), "a mutex is locked")
+		assert.True(t, b.TryLock(), "b mutex is locked")
+		a.Unlock()
+		b.Unlock()
+
+		// the same mutex is locked once.
+		unlock = lockPair(&b, &b, shared)
+		unlock()
+		assert.True(t, b.TryLock(), "b mutex is locked")
+		b.Unlock()
+	}
+}
+
+func Test_Unsync_constructors(t *testing.T) {
+	avl, bst := NewAvlUnsync(), NewBstUnsync()
+	list, sortedList := NewListUnsync(true), NewSortedListUnsync(true)
+	queue, stack := NewQueueUnsync(), NewStackUnsync()
+	rbt, treap, splay := NewRbtUnsync(), NewTreapUnsync(), NewSplayUnsync()
+	btree, itr := NewBTreeUnsync(1), NewIntervalTreeUnsync()
+	skipList := NewSkipListUnsync()
+
+	locks := map[string]*rwLock{
+		"Avl":          &avl.mutex,
+		"Bst":          &bst.mutex,
+		"List":         &list.mutex,
+		"SortedList":   &sortedList.list.mutex,
+		"Queue":        &queue.mutex,
+		"Stack":        &stack.mutex,
+		"Rbt":          &rbt.mutex,
+		"Treap":        &treap.mutex,
+		"Splay":        &splay.mutex,
+		"BTree":        &btree.mutex,
+		"IntervalTree": &itr.mutex,
+		"SkipList":     &skipList.mutex,
+	}
+
+	for name, lock := range locks {
+		assert.True(t, lock.disabled, "%s lock isn't disabled", name)
+	}
+
+	assert.True(t, avl.rebalance, "Avl tree isn't balanced")
+	assert.False(t, bst.rebalance, "Bst tree is balanced")
+	assert.True(t, list.avl.duplicated, "List duplicated flag is incorrect")
+	assert.NotNil(t, treap.rnd, "Treap random source is nil")
+	assert.NotNil(t, skipList.rnd, "SkipList random source is nil")
+	assert.Equal(t, btree.degree, 2, "BTree degree is incorrect")
+
+	// the structs work as the synchronized structs.
+	for num := 0; num < 10; num++ {
+		avl.Insert(It(num))
+		list.AddAfter(It(num))
+		queue.Enqueue(It(num))
+		skipList.Insert(It(num))
+	}
+
+	assert.Equal(t, treeValues(&avl.Tree), intRange(0, 10), "Avl items don't match")
+	assert.Equal(t, list.Length(), 10, "List length doesn't match")
+	assert.Equal(t, queue.Length(), 10, "Queue length doesn't match")
+	assert.Equal(t, skipList.Length(), 10, "SkipList length doesn't match")
+}
+
+func Test_List_inner_tree_unlocked(t *testing.T) {
+	list := NewList(false)
+	assert.True(t, list.avl.mutex.disabled, "inner tree lock isn't disabled")
+
+	list.AddAfter(It(1))
+	list.Clear()
+	assert.True(t, list.avl.mutex.disabled, "inner tree lock isn't disabled after Clear")
+}
+
+func Test_List_emptyCopy_func(t *testing.T) {
+	for _, newList := range []func(bool) List{NewList, NewListUnsync} {
+		list := newList(false)
+		list.AddAfter(It(1))
+		disabled := list.mutex.disabled
+
+		copied := list.emptyCopy()
+		mapped := list.Map(func(it Item) Item { return it })
+		filtered := list.Filter(func(it Item) bool { return true })
+
+		assert.Equal(t, copied.mutex.disabled, disabled, "copy lock doesn't match")
+		assert.Equal(t, mapped.mutex.disabled, disabled, "map lock doesn't match")
+		assert.Equal(t, filtered.mutex.disabled, disabled, "filter lock doesn't match")
+	}
+}
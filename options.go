@@ -0,0 +1,152 @@
+package mygostructs
+
+// Comparator is a function that compares the a and b items. It returns a negative number if a is
+// less than b, 0 if they are equal and a positive number if a is greater than b. The structs
+// created with a comparator use it instead of the Less and Eq functions of the items.
+type Comparator func(a, b Item) int
+
+// less checks if the a item is less than the b item. If the comparator is nil, it uses the Less
+// function of the a item.
+func (cmp Comparator) less(a, b Item) bool {
+	if cmp == nil {
+		return a.Less(b)
+	}
+
+	return cmp(a, b) < 0
+}
+
+// eq checks if the a item is equal to the b item. If the comparator is nil, it uses the Eq
+// function of the a item.
+func (cmp Comparator) eq(a, b Item) bool {
+	if cmp == nil {
+		return a.Eq(b)
+	}
+
+	return cmp(a, b) == 0
+}
+
+// compare compares the a and b items in only one step. It returns a negative number if a is less
+// than b, 0 if they are equal and a positive number if a is greater than b. If the comparator is
+// nil, it uses the Compare function of the a item if it implements the Comparer interface, or its
+// Less and Eq functions otherwise.
+func (cmp Comparator) compare(a, b Item) int {
+	if cmp != nil {
+		return cmp(a, b)
+	}
+
+	if c, ok := a.(Comparer); ok {
+		return c.Compare(b)
+	}
+
+	switch {
+	case a.Less(b):
+		return -1
+	case a.Eq(b):
+		return 0
+	}
+
+	return 1
+}
+
+// boundComparator compares an item with other items in only one step. It checks only once if
+// the item implements the Comparer interface, so the searches don't repeat the check in each node.
+type boundComparator struct {
+	cmp      Comparator // comparator of the struct.
+	it       Item       // item compared.
+	comparer Comparer   // item compared as Comparer, or nil if it isn't a Comparer.
+}
+
+// bind returns the boundComparator that compares the it item with other items.
+func (cmp Comparator) bind(it Item) boundComparator {
+	bc := boundComparator{cmp: cmp, it: it}
+	if cmp == nil {
+		bc.comparer, _ = it.(Comparer)
+	}
+
+	return bc
+}
+
+// compare compares the item of the bc comparator with the other item, as the compare function of
+// the Comparator type.
+func (bc boundComparator) compare(other Item) int {
+	switch {
+	case bc.comparer != nil:
+		return bc.comparer.Compare(other)
+	case bc.cmp != nil:
+		return bc.cmp(bc.it, other)
+	case bc.it.Less(other):
+		return -1
+	case bc.it.Eq(other):
+		return 0
+	}
+
+	return 1
+}
+
+// options are the settings of a struct, used by its constructor.
+type options struct {
+	duplicated bool       // Flag indicating if allows duplicated items.
+	compare    Comparator // Function for comparing the items. If nil, Less and Eq are used.
+	capacity   int        // Max number of items. If it is 0, the number isn't limited.
+	unlocked   bool       // Flag indicating if the lock of the struct is disabled.
+}
+
+// Option is a setting of the structs, used in the constructors that end with With: NewAvlWith,
+// NewListWith, NewQueueWith... The settings that a struct doesn't use are ignored, for example
+// the queue ignores the comparator.
+type Option func(*options)
+
+// WithDuplicates sets if the struct allows duplicated items. By default, they aren't allowed.
+func WithDuplicates(allow bool) Option {
+	return func(o *options) {
+		o.duplicated = allow
+	}
+}
+
+// WithComparator sets the function used for comparing the items of the struct. By default, the
+// struct uses the Less and Eq functions of the items.
+func WithComparator(cmp Comparator) Option {
+	return func(o *options) {
+		o.compare = cmp
+	}
+}
+
+// WithLock sets if the struct is locked in each operation. By default, it is locked, so it can be
+// used by several goroutines at the same time. A struct without lock is faster, but it only can be
+// used by one goroutine.
+func WithLock(enabled bool) Option {
+	return func(o *options) {
+		o.unlocked = !enabled
+	}
+}
+
+// WithCapacity sets the max number of items of the struct. The operations that insert items fail
+// when the struct is full. If capacity is 0 or less, the number of items isn't limited, as by
+// default.
+func WithCapacity(capacity int) Option {
+	return func(o *options) {
+		o.capacity = max(capacity, 0)
+	}
+}
+
+// newOptions returns the settings with the options of the parameter applied.
+func newOptions(opts []Option) options {
+	var o options
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// lock returns the lock of a struct created with the settings.
+func (o options) lock() rwLock {
+	return rwLock{disabled: o.unlocked}
+}
+
+// isFull checks if a struct with the length number of items cannot store more items, because it
+// reached the capacity. If capacity is 0, the struct is never full.
+func isFull(length, capacity int) bool {
+	return capacity > 0 && length >= capacity
+}
@@ -0,0 +1,113 @@
+package mygostructs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// reverse compares the IntItem items in reverse order.
+func reverse(a, b Item) int {
+	return b.(IntItem).value - a.(IntItem).value
+}
+
+// lastDigit compares the IntItem items by the last digit of their values, so It(3) and It(13) are
+// equal.
+func lastDigit(a, b Item) int {
+	return a.(IntItem).value%10 - b.(IntItem).value%10
+}
+
+func Test_newOptions_func(t *testing.T) {
+	as := assert.New(t)
+
+	o := newOptions(nil)
+	as.False(o.duplicated, "duplicated flag is incorrect")
+	as.Nil(o.compare, "comparator isn't nil")
+	as.Equal(o.capacity, 0, "capacity is incorrect")
+	as.False(o.lock().disabled, "lock is disabled")
+
+	o = newOptions([]Option{
+		WithDuplicates(true),
+		WithComparator(reverse),
+		WithCapacity(10),
+		WithLock(false),
+	})
+
+	as.True(o.duplicated, "duplicated flag is incorrect")
+	as.NotNil(o.compare, "comparator is nil")
+	as.Equal(o.capacity, 10, "capacity is incorrect")
+	as.True(o.lock().disabled, "lock isn't disabled")
+
+	// the last option wins.
+	o = newOptions([]Option{WithCapacity(10), WithCapacity(-5)})
+	as.Equal(o.capacity, 0, "negative capacity isn't unlimited")
+
+	o = newOptions([]Option{WithLock(false), WithLock(true)})
+	as.False(o.lock().disabled, "lock is disabled")
+}
+
+func Test_Comparator_func(t *testing.T) {
+	as := assert.New(t)
+	var cmp Comparator
+
+	// without comparator the Less and Eq functions are used.
+	as.True(cmp.less(It(1), It(2)), "1 isn't less than 2")
+	as.False(cmp.less(It(2), It(1)), "2 is less than 1")
+	as.True(cmp.eq(It(1), It(1)), "1 isn't equal to 1")
+
+	cmp = reverse
+	as.False(cmp.less(It(1), It(2)), "1 is less than 2")
+	as.True(cmp.less(It(2), It(1)), "2 isn't less than 1")
+	as.True(cmp.eq(It(1), It(1)), "1 isn't equal to 1")
+	as.False(cmp.eq(It(1), It(2)), "1 is equal to 2")
+
+	cmp = lastDigit
+	as.True(cmp.eq(It(3), It(13)), "3 isn't equal to 13")
+	as.Negative(cmp.compare(It(13), It(4)), "13 isn't less than 4")
+}
+
+func Test_Comparator_compare_func(t *testing.T) {
+	as := assert.New(t)
+	var cmp Comparator
+
+	// the items with Compare function.
+	as.Negative(cmp.compare(It(1), It(2)), "1 isn't less than 2")
+	as.Zero(cmp.compare(It(1), It(1)), "1 isn't equal to 1")
+	as.Positive(cmp.compare(It(2), It(1)), "2 isn't greater than 1")
+
+	// the items without Compare function use Less and Eq.
+	as.Negative(cmp.compare(testItem{1}, testItem{2}), "1 isn't less than 2")
+	as.Zero(cmp.compare(testItem{1}, testItem{1}), "1 isn't equal to 1")
+	as.Positive(cmp.compare(testItem{2}, testItem{1}), "2 isn't greater than 1")
+
+	cmp = reverse
+	as.Positive(cmp.compare(It(1), It(2)), "comparator isn't used")
+}
+
+func Test_boundComparator_func(t *testing.T) {
+	as := assert.New(t)
+	var cmp Comparator
+
+	bc := cmp.bind(It(2))
+	as.NotNil(bc.comparer, "Comparer interface isn't detected")
+	as.Positive(bc.compare(It(1)), "2 isn't greater than 1")
+	as.Zero(bc.compare(It(2)), "2 isn't equal to 2")
+	as.Negative(bc.compare(It(3)), "2 isn't less than 3")
+
+	bc = cmp.bind(testItem{2})
+	as.Nil(bc.comparer, "item without Compare function is a Comparer")
+	as.Positive(bc.compare(testItem{1}), "2 isn't greater than 1")
+	as.Zero(bc.compare(testItem{2}), "2 isn't equal to 2")
+	as.Negative(bc.compare(testItem{3}), "2 isn't less than 3")
+
+	// the comparator of the struct has priority over the Compare function.
+	cmp = reverse
+	bc = cmp.bind(It(2))
+	as.Nil(bc.comparer, "Compare function is used with comparator")
+	as.Negative(bc.compare(It(1)), "comparator isn't used")
+}
+
+func Test_isFull_func(t *testing.T) {
+	assert.False(t, isFull(100, 0), "struct without capacity is full")
+	assert.False(t, isFull(4, 5), "struct is full")
+	assert.True(t, isFull(5, 5), "struct isn't full")
+}
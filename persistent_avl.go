@@ -0,0 +1,256 @@
+package mygostructs
+
+// copyNode returns a copy of the node. If node is nil, then returns nil.
+func copyNode(node *treeNode) *treeNode {
+	if node == nil {
+		return nil
+	}
+
+	nodeCopy := *node
+	return &nodeCopy
+}
+
+// copyTree returns a copy of all nodes of the node tree.
+func copyTree(node *treeNode) *treeNode {
+	if node == nil {
+		return nil
+	}
+
+	nodeCopy := copyNode(node)
+	nodeCopy.ltree = copyTree(node.ltree)
+	nodeCopy.rtree = copyTree(node.rtree)
+	return nodeCopy
+}
+
+// rebalanceCopy rebalances the node, that must be a copy, without modifying the nodes shared with
+// other trees. The rotations modify the child of the higher subtree and its inner child, so they
+// are copied before.
+func rebalanceCopy(node *treeNode) *treeNode {
+	switch node.ltree.getHeight() - node.rtree.getHeight() {
+	case 2:
+		node.ltree = copyNode(node.ltree)
+		node.ltree.rtree = copyNode(node.ltree.rtree)
+
+	case -2:
+		node.rtree = copyNode(node.rtree)
+		node.rtree.ltree = copyNode(node.rtree.ltree)
+	}
+
+	return rebalance(node)
+}
+
+// insertCopy inserts the it item in the node tree, copying the nodes of the path to the new node
+// instead of modifying them. If duplicated parameter is false, the item inserted must be unique.
+// The items are compared with the cmp comparator. The function returns the root of the new tree
+// and a flag indicating if the item was inserted.
+func insertCopy(node *treeNode, it Item, duplicated bool, cmp Comparator) (*treeNode, bool) {
+	var (
+		child    *treeNode
+		inserted bool
+	)
+
+	if node == nil {
+		return &treeNode{nil, nil, 0, 1, it}, true
+	}
+
+	c := cmp.compare(it, node.item)
+	if !duplicated && c == 0 {
+		return node, false
+	}
+
+	right := c >= 0
+	if right {
+		child, inserted = insertCopy(node.rtree, it, duplicated, cmp)
+	} else {
+		child, inserted = insertCopy(node.ltree, it, duplicated, cmp)
+	}
+
+	if !inserted {
+		return node, false
+	}
+
+	node = copyNode(node)
+	if right {
+		node.rtree = child
+	} else {
+		node.ltree = child
+	}
+
+	node.size++
+	return rebalanceCopy(node), true
+}
+
+// deleteMinCopy deletes the node with the smallest item of the node tree, copying the nodes of
+// the path. Returns the root of the new tree and the item deleted.
+func deleteMinCopy(node *treeNode) (*treeNode, Item) {
+	var min Item
+
+	if node.ltree == nil {
+		return node.rtree, node.item
+	}
+
+	node = copyNode(node)
+	node.ltree, min = deleteMinCopy(node.ltree)
+	node.size--
+	return rebalanceCopy(node), min
+}
+
+// deleteCopy searchs the it item in the node tree and deletes it, copying the nodes of the path
+// instead of modifying them. The items are compared with the cmp comparator. The function returns
+// the root of the new tree, the item deleted and a flag indicating if the item existed in the tree.
+func deleteCopy(node *treeNode, it Item, cmp Comparator) (*treeNode, Item, bool) {
+	var (
+		child     *treeNode
+		itDeleted Item
+		found     bool
+	)
+
+	if node == nil {
+		return nil, nil, false
+	}
+
+	c := cmp.compare(it, node.item)
+	if c == 0 {
+		if node.ltree == nil {
+			return node.rtree, node.item, true
+		}
+
+		if node.rtree == nil {
+			return node.ltree, node.item, true
+		}
+
+		// The node has two children. Its item is replaced by the next item, and the node of
+		// the next item is deleted from the right subtree.
+		itDeleted = node.item
+		node = copyNode(node)
+		node.rtree, node.item = deleteMinCopy(node.rtree)
+		node.size--
+		return rebalanceCopy(node), itDeleted, true
+	}
+
+	right := c > 0
+	if right {
+		child, itDeleted, found = deleteCopy(node.rtree, it, cmp)
+	} else {
+		child, itDeleted, found = deleteCopy(node.ltree, it, cmp)
+	}
+
+	if !found {
+		return node, nil, false
+	}
+
+	node = copyNode(node)
+	if right {
+		node.rtree = child
+	} else {
+		node.ltree = child
+	}
+
+	node.size--
+	return rebalanceCopy(node), itDeleted, true
+}
+
+// PersistentAvl is a struct it implements a persistent AVL tree type data structure. The tree is
+// never modified: the Insert and Delete functions return a new version of the tree, that shares
+// with the old version the nodes that weren't changed. So a version can be read while other
+// versions are created, and it is copied in O(1) time and memory.
+//
+// The struct doesn't use locks. Each version can be read by several threads at the same time,
+// without synchronization. The items stored in the tree shouldn't be modified.
+type PersistentAvl struct {
+	root       *treeNode  // Tree root.
+	duplicated bool       // Flag indicating if allows duplicated items.
+	compare    Comparator // Function for comparing the items. If nil, Less and Eq are used.
+	capacity   int        // Max number of items. If it is 0, the number isn't limited.
+}
+
+// NewPersistentAvl creates an empty persistent AVL tree.
+func NewPersistentAvl() PersistentAvl {
+	return NewPersistentAvlWith()
+}
+
+// NewPersistentAvlWith creates an empty persistent AVL tree with the options of the parameters.
+// The tree uses the duplicated items, comparator and capacity options. It doesn't have lock, so
+// the lock option is ignored.
+func NewPersistentAvlWith(opts ...Option) PersistentAvl {
+	o := newOptions(opts)
+	return PersistentAvl{duplicated: o.duplicated, compare: o.compare, capacity: o.capacity}
+}
+
+// withRoot returns a version of the pa tree with the nodes of the root tree.
+func (pa PersistentAvl) withRoot(root *treeNode) PersistentAvl {
+	pa.root = root
+	return pa
+}
+
+// NewPersistentAvlFromSlice creates a perfectly balanced persistent AVL tree with the items of the
+// slice and the options of the parameters, as the NewPersistentAvlWith function. It works as the
+// NewAvlFromSlice function.
+func NewPersistentAvlFromSlice(items []Item, opts ...Option) PersistentAvl {
+	pa := NewPersistentAvlWith(opts...)
+	pa.root, _ = sliceNodes(items, pa.duplicated, pa.compare, pa.capacity)
+	return pa
+}
+
+// Insert returns a new version of the tree with the item inserted. The second value returned is a
+// flag indicating if the item was inserted. If the item cannot be inserted because it was
+// duplicated or the tree is full, the same version is returned.
+func (pa PersistentAvl) Insert(it Item) (PersistentAvl, bool) {
+	if isFull(pa.root.getSize(), pa.capacity) {
+		return pa, false
+	}
+
+	root, inserted := insertCopy(pa.root, it, pa.duplicated, pa.compare)
+	return pa.withRoot(root), inserted
+}
+
+// Delete returns a new version of the tree without the item. It also returns the item deleted and
+// a flag indicating if the item existed in the tree. If the item didn't exist, the same version is
+// returned.
+func (pa PersistentAvl) Delete(it Item) (PersistentAvl, Item, bool) {
+	root, itd, deleted := deleteCopy(pa.root, it, pa.compare)
+	return pa.withRoot(root), itd, deleted
+}
+
+// Search searchs the item in the tree. It returns the item found and a flag indicating if the item
+// exists in the tree.
+func (pa PersistentAvl) Search(it Item) (Item, bool) {
+	if node, found := search(pa.root, it, pa.compare); found {
+		return node.item, true
+	}
+
+	return nil, false
+}
+
+// Length returns the number of items in the tree.
+func (pa PersistentAvl) Length() int {
+	return pa.root.getSize()
+}
+
+// Min returns the smallest item of the tree. The second value returned is a flag indicating if
+// the item exists or the tree is empty.
+func (pa PersistentAvl) Min() (Item, bool) {
+	return nodeItem(minNode(pa.root))
+}
+
+// Max returns the greatest item of the tree. The second value returned is a flag indicating if
+// the item exists or the tree is empty.
+func (pa PersistentAvl) Max() (Item, bool) {
+	return nodeItem(maxNode(pa.root))
+}
+
+// Traverse executes the function of the parameter with the items of the tree, in the order of
+// the parameter. The traverse stops when the function returns false.
+func (pa PersistentAvl) Traverse(order TraversalOrder, f func(Item) bool) {
+	walk(pa.root, order, false, func(node *treeNode) bool {
+		return f(node.item)
+	})
+}
+
+// Range executes the function of the parameter with the items of the tree that are inside of the
+// b range, sorted. The iteration stops when the function returns false.
+func (pa PersistentAvl) Range(b Bounds, f func(Item) bool) {
+	walkRange(pa.root, b, pa.compare, func(node *treeNode) bool {
+		return f(node.item)
+	})
+}
@@ -0,0 +1,303 @@
+package mygostructs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// persistentValues returns the values of the persistent tree items, sorted.
+func persistentValues(pa PersistentAvl) []int {
+	values := []int{}
+
+	pa.Traverse(InOrder, func(it Item) bool {
+		values = append(values, it.(IntItem).value)
+		return true
+	})
+
+	return values
+}
+
+// itemRange returns a slice with the items from min to max - 1.
+func itemRange(min, max int) []Item {
+	items := []Item{}
+	for num := min; num < max; num++ {
+		items = append(items, It(num))
+	}
+
+	return items
+}
+
+// checkPersistentAvl checks the items and the properties of the persistent tree.
+func checkPersistentAvl(t *testing.T, pa PersistentAvl, values []int) {
+	assert.Equal(t, persistentValues(pa), values, "items don't match")
+	assert.Equal(t, pa.Length(), len(values), "length doesn't match")
+	checkHeights(t, pa.root)
+	checkSizes(t, pa.root)
+}
+
+// sharedNodes returns the number of nodes of the a tree that are also in the b tree.
+func sharedNodes(a, b *treeNode) int {
+	nodes := map[*treeNode]bool{}
+	count := 0
+
+	walk(b, InOrder, false, func(node *treeNode) bool {
+		nodes[node] = true
+		return true
+	})
+
+	walk(a, InOrder, false, func(node *treeNode) bool {
+		if nodes[node] {
+			count++
+		}
+		return true
+	})
+
+	return count
+}
+
+func Test_NewPersistentAvl_func(t *testing.T) {
+	pa := NewPersistentAvl()
+
+	assert.Nil(t, pa.root, "tree root isn't nil")
+	assert.False(t, pa.duplicated, "tree duplicated flag is incorrect")
+	checkPersistentAvl(t, pa, []int{})
+}
+
+func Test_NewPersistentAvlFromSlice_func(t *testing.T) {
+	pa := NewPersistentAvlFromSlice([]Item{It(3), It(1), It(2), It(3), It(0)})
+	checkPersistentAvl(t, pa, []int{0, 1, 2, 3})
+
+	pa = NewPersistentAvlFromSlice([]Item{It(3), It(1), It(3), It(0)}, WithDuplicates(true))
+	checkPersistentAvl(t, pa, []int{0, 1, 3, 3})
+
+	pa = NewPersistentAvlFromSlice([]Item{It(3), It(1), It(0)}, WithCapacity(2))
+	checkPersistentAvl(t, pa, []int{0, 1})
+	_, inserted := pa.Insert(It(5))
+	assert.False(t, inserted, "item was inserted in full tree")
+}
+
+func Test_NewPersistentAvlWith_func(t *testing.T) {
+	as := assert.New(t)
+	pa := NewPersistentAvlWith(WithComparator(lastDigit), WithCapacity(3))
+
+	for _, num := range []int{1, 11, 2, 3, 4} {
+		version, inserted := pa.Insert(It(num))
+		as.Equal(inserted, num != 11 && num != 4, "insert of %d is incorrect", num)
+		pa = version
+	}
+
+	checkPersistentAvl(t, pa, []int{1, 2, 3})
+
+	item, found := pa.Search(It(12))
+	as.True(found, "item not found")
+	as.Equal(item, It(2), "item found is incorrect")
+
+	pa, item, _ = pa.Delete(It(23))
+	as.Equal(item, It(3), "item deleted is incorrect")
+
+	pa, _ = pa.Insert(It(4))
+	checkPersistentAvl(t, pa, []int{1, 2, 4})
+
+	pa = NewPersistentAvlWith(WithDuplicates(true))
+	pa, _ = pa.Insert(It(1))
+	pa, _ = pa.Insert(It(1))
+	checkPersistentAvl(t, pa, []int{1, 1})
+}
+
+func Test_PersistentAvl_Insert_func(t *testing.T) {
+	as := assert.New(t)
+	versions := []PersistentAvl{NewPersistentAvl()}
+	inserted := []int{}
+
+	for num := 0; num < 100; num++ {
+		pa, ok := versions[num].Insert(It(num * 37 % 100))
+		as.True(ok, "item %d wasn't inserted", num*37%100)
+		versions = append(versions, pa)
+	}
+
+	// the old versions don't change.
+	for num, pa := range versions {
+		values := make([]int, len(inserted))
+		copy(values, inserted)
+		sort.Ints(values)
+
+		checkPersistentAvl(t, pa, values)
+		if num < 100 {
+			inserted = append(inserted, num*37%100)
+		}
+	}
+
+	last := versions[100]
+	pa, ok := last.Insert(It(5))
+	as.False(ok, "duplicated item was inserted")
+	as.True(pa.root == last.root, "the version changed")
+
+	// only the nodes of the path are copied.
+	pa, _ = last.Insert(It(100))
+	minShared := 100 - 2*(last.root.height+1)
+	as.GreaterOrEqual(sharedNodes(pa.root, last.root), minShared, "nodes aren't shared")
+
+	// duplicated items
+	pa = PersistentAvl{duplicated: true}
+	for num := 0; num < 6; num++ {
+		pa, ok = pa.Insert(It(num % 3))
+		as.True(ok, "duplicated item wasn't inserted")
+	}
+
+	checkPersistentAvl(t, pa, []int{0, 0, 1, 1, 2, 2})
+}
+
+func Test_PersistentAvl_Delete_func(t *testing.T) {
+	as := assert.New(t)
+	full := NewPersistentAvlFromSlice(itemRange(0, 100))
+	pa := full
+	expected := []int{}
+
+	for num := 0; num < 100; num++ {
+		if num%3 != 0 {
+			expected = append(expected, num)
+			continue
+		}
+
+		var (
+			item    Item
+			deleted bool
+		)
+
+		pa, item, deleted = pa.Delete(It(num))
+		as.True(deleted, "item %d wasn't deleted", num)
+		as.Equal(item, It(num), "item deleted is incorrect")
+	}
+
+	checkPersistentAvl(t, pa, expected)
+	checkPersistentAvl(t, full, intRange(0, 100))
+
+	same, item, deleted := pa.Delete(It(0))
+	as.False(deleted, "item deleted twice")
+	as.Nil(item, "item deleted isn't nil")
+	as.True(same.root == pa.root, "the version changed")
+
+	for _, num := range expected {
+		pa, _, _ = pa.Delete(It(num))
+	}
+
+	checkPersistentAvl(t, pa, []int{})
+	checkPersistentAvl(t, full, intRange(0, 100))
+}
+
+func Test_PersistentAvl_versions(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	pa := PersistentAvl{duplicated: true}
+	avl := Avl{Tree{rebalance: true, duplicated: true}}
+	versions := []PersistentAvl{}
+	values := [][]int{}
+
+	// each version must contain the same items than an Avl tree with the same operations.
+	for i := 0; i < 500; i++ {
+		item := It(rnd.Intn(50))
+
+		if rnd.Intn(3) == 0 {
+			pa, _, _ = pa.Delete(item)
+			avl.Delete(item)
+		} else {
+			pa, _ = pa.Insert(item)
+			avl.Insert(item)
+		}
+
+		versions = append(versions, pa)
+		values = append(values, treeValues(&avl.Tree))
+	}
+
+	for i, version := range versions {
+		checkPersistentAvl(t, version, values[i])
+	}
+}
+
+func Test_PersistentAvl_func_sync(t *testing.T) {
+	as := assert.New(t)
+	pa := NewPersistentAvlFromSlice(itemRange(0, 1000))
+	done := make(chan bool)
+	concurrence := 4
+
+	// the readers use the first version while the writer creates new versions.
+	read := func(pa PersistentAvl) {
+		for i := 0; i < 1000; i++ {
+			_, found := pa.Search(It(i))
+			as.True(found, "item %d not found", i)
+		}
+
+		as.Equal(pa.Length(), 1000, "length doesn't match")
+		done <- true
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go read(pa)
+	}
+
+	go func() {
+		version := pa
+		for i := 0; i < 1000; i++ {
+			version, _, _ = version.Delete(It(i))
+			version, _ = version.Insert(It(i + 1000))
+		}
+
+		checkPersistentAvl(t, version, intRange(1000, 2000))
+		done <- true
+	}()
+
+	for i := 0; i <= concurrence; i++ {
+		<-done
+	}
+
+	checkPersistentAvl(t, pa, intRange(0, 1000))
+}
+
+func Test_PersistentAvl_Search_func(t *testing.T) {
+	as := assert.New(t)
+	pa := NewPersistentAvl()
+
+	for i := 0; i < 50; i++ {
+		pa, _ = pa.Insert(It(i * 2))
+	}
+
+	for i := 0; i < 50; i++ {
+		result, found := pa.Search(It(i * 2))
+		as.True(found, "item %d not found", i*2)
+		as.Equal(result, It(i*2), "item found doesn't match")
+
+		result, found = pa.Search(It(i*2 + 1))
+		as.False(found, "item %d found", i*2+1)
+		as.Nil(result, "result isn't nil")
+	}
+}
+
+func Test_PersistentAvl_MinMax_func(t *testing.T) {
+	as := assert.New(t)
+	pa := NewPersistentAvl()
+
+	_, found := pa.Min()
+	as.False(found, "min found in empty tree")
+	_, found = pa.Max()
+	as.False(found, "max found in empty tree")
+
+	pa = NewPersistentAvlFromSlice(itemRange(5, 20))
+
+	item, _ := pa.Min()
+	as.Equal(item, It(5), "min is incorrect")
+	item, _ = pa.Max()
+	as.Equal(item, It(19), "max is incorrect")
+}
+
+func Test_PersistentAvl_Range_func(t *testing.T) {
+	pa := NewPersistentAvlFromSlice(itemRange(0, 20))
+	values := []int{}
+
+	pa.Range(Bounds{It(5), It(10), true, false}, func(it Item) bool {
+		values = append(values, it.(IntItem).value)
+		return true
+	})
+
+	assert.Equal(t, values, intRange(5, 10), "range items don't match")
+}
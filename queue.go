@@ -1,7 +1,5 @@
 package mygostructs
 
-import "sync"
-
 //queueNode is the node for Queue struct.
 type queueNode struct {
 	item Item
@@ -11,24 +9,50 @@ type queueNode struct {
 // Queue is a struct it implements a queue type abstract data structure, where the items are
 // inserted linearly and the first element in enter is the first element in out. (FIFO).
 //
-// The struct is adapted to run in multithread code.
+// The struct is adapted to run in multithread code. Front and Length only take the read lock, so
+// they run in parallel.
 type Queue struct {
-	length int
-	fnode  *queueNode
-	lnode  *queueNode
-	mutex  sync.Mutex
+	length   int
+	capacity int // Max number of items. If it is 0, the number isn't limited.
+	fnode    *queueNode
+	lnode    *queueNode
+	mutex    rwLock
 }
 
 // NewQueue creates and returns a new empty queue.
 func NewQueue() Queue {
-	return Queue{}
+	return NewQueueWith()
+}
+
+// NewQueueUnsync creates and returns a new empty queue without lock. It is faster than the queue
+// created with NewQueue, but it cannot be used by several goroutines at the same time.
+func NewQueueUnsync() Queue {
+	return NewQueueWith(WithLock(false))
 }
 
-// Enqueue adds the item of the paramter in the end of the queue.
+// NewQueueWith creates and returns a new empty queue with the options of the parameters. The queue
+// uses the lock and capacity options, the rest of options are ignored.
+func NewQueueWith(opts ...Option) Queue {
+	o := newOptions(opts)
+	return Queue{capacity: o.capacity, mutex: o.lock()}
+}
+
+// Enqueue adds the item of the paramter in the end of the queue. If the queue is full, the item
+// isn't added.
 func (qu *Queue) Enqueue(it Item) {
+	qu.TryEnqueue(it)
+}
+
+// TryEnqueue adds the item in the end of the queue, as the Enqueue function. Returns a flag
+// indicating if the item was added or the queue is full.
+func (qu *Queue) TryEnqueue(it Item) bool {
 	qu.mutex.Lock()
 	defer qu.mutex.Unlock()
 
+	if isFull(qu.length, qu.capacity) {
+		return false
+	}
+
 	node := &queueNode{item: it}
 
 	if qu.length == 0 {
@@ -40,6 +64,7 @@ func (qu *Queue) Enqueue(it Item) {
 
 	qu.lnode = node
 	qu.length++
+	return true
 }
 
 // Dequeue returns and delete the first item of the queue. The second value returned is flag
@@ -62,8 +87,8 @@ func (qu *Queue) Dequeue() (Item, bool) {
 // Front reads the first item in the queue. The second value is a flag indicating if the item
 // was read successlly.
 func (qu *Queue) Front() (Item, bool) {
-	qu.mutex.Lock()
-	defer qu.mutex.Unlock()
+	qu.mutex.RLock()
+	defer qu.mutex.RUnlock()
 
 	if qu.length > 0 {
 		return qu.fnode.item, true
@@ -74,8 +99,8 @@ func (qu *Queue) Front() (Item, bool) {
 
 // Length returns the number of items in the queue.
 func (qu *Queue) Length() int {
-	qu.mutex.Lock()
-	defer qu.mutex.Unlock()
+	qu.mutex.RLock()
+	defer qu.mutex.RUnlock()
 	return qu.length
 }
 
@@ -42,6 +42,29 @@ func Test_NewQueue_func(t *testing.T) {
 	as.Equal(queue.length, 0, "in empty queue, the length isn't 0")
 }
 
+func Test_NewQueueWith_func(t *testing.T) {
+	as := assert.New(t)
+	queue := NewQueueWith(WithCapacity(3), WithLock(false))
+
+	as.True(queue.mutex.disabled, "queue lock isn't disabled")
+
+	for num := 1; num <= 5; num++ {
+		as.Equal(queue.TryEnqueue(It(num)), num <= 3, "enqueue of %d is incorrect", num)
+	}
+
+	checkQueueNode(t, queue.lnode, 3, nil)
+	as.Equal(queue.Length(), 3, "queue length doesn't match")
+
+	queue.Dequeue()
+	as.True(queue.TryEnqueue(It(4)), "item wasn't enqueued")
+	checkQueueNode(t, queue.lnode, 4, nil)
+
+	// Enqueue discards the item when the queue is full.
+	queue.Enqueue(It(5))
+	checkQueueNode(t, queue.lnode, 4, nil)
+	as.Equal(queue.Length(), 3, "queue length doesn't match")
+}
+
 func Test_Queue_Enqueue_func(t *testing.T) {
 	queue := NewQueue()
 
@@ -269,3 +292,11 @@ func Test_Queue_Clear_func(t *testing.T) {
 	as.Nil(queue.lnode, "pointer to last node isn't nil in empty queue")
 	as.Equal(queue.length, 0, "length isn't 0 in empty queue")
 }
+
+func Test_Queue_reads_shared(t *testing.T) {
+	queue := NewQueue()
+	queue.Enqueue(It(1))
+
+	checkSharedRead(t, &queue.mutex, "Front", func() { queue.Front() })
+	checkSharedRead(t, &queue.mutex, "Length", func() { queue.Length() })
+}
@@ -0,0 +1,351 @@
+package mygostructs
+
+// rbNode is the internal node of the red-black tree.
+type rbNode struct {
+	left, right, parent *rbNode
+	red                 bool
+	item                Item
+}
+
+// isRed checks if the node is red. The nil nodes are black.
+func (node *rbNode) isRed() bool {
+	return node != nil && node.red
+}
+
+// Rbt is a struct it implements a red-black tree type data structure. It does less rotations than
+// the AVL tree when items are inserted and deleted, so it is faster in workloads with a lot of
+// writes. The searches can be a bit slower, because the tree is less balanced.
+//
+// The struct is adapted to run in multithread code.
+type Rbt struct {
+	root       *rbNode    // Tree root.
+	length     int        // Number of tree nodes.
+	duplicated bool       // Flag indicating if allows duplicated items.
+	compare    Comparator // Function for comparing the items. If nil, Less and Eq are used.
+	capacity   int        // Max number of items. If it is 0, the number isn't limited.
+	mutex      rwLock     // Lock for avoid the concurrence when manipulate the struct.
+}
+
+// NewRbt creates an empty red-black tree.
+func NewRbt() Rbt {
+	return NewRbtWith()
+}
+
+// NewRbtUnsync creates an empty red-black tree without lock, for using it in only one goroutine.
+func NewRbtUnsync() Rbt {
+	return NewRbtWith(WithLock(false))
+}
+
+// NewRbtWith creates an empty red-black tree with the options of the parameters. The tree uses
+// all options: duplicated items, comparator, lock and capacity.
+func NewRbtWith(opts ...Option) Rbt {
+	o := newOptions(opts)
+	return Rbt{
+		duplicated: o.duplicated,
+		compare:    o.compare,
+		capacity:   o.capacity,
+		mutex:      o.lock(),
+	}
+}
+
+// rotateLeft executes a left rotation in the node.
+func (rbt *Rbt) rotateLeft(node *rbNode) {
+	child := node.right
+	node.right = child.left
+
+	if child.left != nil {
+		child.left.parent = node
+	}
+
+	rbt.replaceChild(node.parent, node, child)
+	child.left = node
+	node.parent = child
+}
+
+// rotateRight executes a right rotation in the node.
+func (rbt *Rbt) rotateRight(node *rbNode) {
+	child := node.left
+	node.left = child.right
+
+	if child.right != nil {
+		child.right.parent = node
+	}
+
+	rbt.replaceChild(node.parent, node, child)
+	child.right = node
+	node.parent = child
+}
+
+// replaceChild replaces the oldChild node of the parent node by the newChild node. If the parent
+// is nil, the newChild node is the root of the tree.
+func (rbt *Rbt) replaceChild(parent, oldChild, newChild *rbNode) {
+	switch {
+	case parent == nil:
+		rbt.root = newChild
+	case parent.left == oldChild:
+		parent.left = newChild
+	default:
+		parent.right = newChild
+	}
+
+	if newChild != nil {
+		newChild.parent = parent
+	}
+}
+
+// insertFixup restores the red-black properties after inserting the node.
+func (rbt *Rbt) insertFixup(node *rbNode) {
+	for node.parent.isRed() {
+		parent := node.parent
+		grandparent := parent.parent
+
+		if parent == grandparent.left {
+			uncle := grandparent.right
+
+			if uncle.isRed() {
+				parent.red, uncle.red, grandparent.red = false, false, true
+				node = grandparent
+				continue
+			}
+
+			if node == parent.right {
+				node = parent
+				rbt.rotateLeft(node)
+				parent = node.parent
+			}
+
+			parent.red, grandparent.red = false, true
+			rbt.rotateRight(grandparent)
+		} else {
+			uncle := grandparent.left
+
+			if uncle.isRed() {
+				parent.red, uncle.red, grandparent.red = false, false, true
+				node = grandparent
+				continue
+			}
+
+			if node == parent.left {
+				node = parent
+				rbt.rotateRight(node)
+				parent = node.parent
+			}
+
+			parent.red, grandparent.red = false, true
+			rbt.rotateLeft(grandparent)
+		}
+	}
+
+	rbt.root.red = false
+}
+
+// Insert inserts the item in the tree. The function returns a flag indicating if the operation
+// was success or the item cannot be inserted because it was duplicated or the tree is full.
+func (rbt *Rbt) Insert(it Item) bool {
+	var parent *rbNode
+
+	rbt.mutex.Lock()
+	defer rbt.mutex.Unlock()
+
+	if isFull(rbt.length, rbt.capacity) {
+		return false
+	}
+
+	bc := rbt.compare.bind(it)
+	for node := rbt.root; node != nil; {
+		c := bc.compare(node.item)
+		if !rbt.duplicated && c == 0 {
+			return false
+		}
+
+		parent = node
+		if c < 0 {
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+
+	node := &rbNode{parent: parent, red: true, item: it}
+
+	switch {
+	case parent == nil:
+		rbt.root = node
+	case rbt.compare.less(it, parent.item):
+		parent.left = node
+	default:
+		parent.right = node
+	}
+
+	rbt.insertFixup(node)
+	rbt.length++
+	return true
+}
+
+// search searchs the item in the tree. Returns the node that contains the item or nil if the
+// item isn't found.
+func (rbt *Rbt) search(it Item) *rbNode {
+	bc := rbt.compare.bind(it)
+	for node := rbt.root; node != nil; {
+		c := bc.compare(node.item)
+		if c == 0 {
+			return node
+		}
+
+		if c > 0 {
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+
+	return nil
+}
+
+// Search searchs the item in the tree. It returns the item found and a flag indicating if
+// the item exists in the tree tree.
+func (rbt *Rbt) Search(it Item) (Item, bool) {
+	rbt.mutex.RLock()
+	defer rbt.mutex.RUnlock()
+
+	if node := rbt.search(it); node != nil {
+		return node.item, true
+	}
+
+	return nil, false
+}
+
+// deleteFixup restores the red-black properties after deleting a black node. The node param is
+// the node that replaced the node deleted, and it can be nil. The parent param is its parent.
+func (rbt *Rbt) deleteFixup(node, parent *rbNode) {
+	for node != rbt.root && !node.isRed() {
+		if node == parent.left {
+			sibling := parent.right
+
+			if sibling.isRed() {
+				sibling.red, parent.red = false, true
+				rbt.rotateLeft(parent)
+				sibling = parent.right
+			}
+
+			if !sibling.left.isRed() && !sibling.right.isRed() {
+				sibling.red = true
+				node, parent = parent, parent.parent
+				continue
+			}
+
+			if !sibling.right.isRed() {
+				sibling.left.red, sibling.red = false, true
+				rbt.rotateRight(sibling)
+				sibling = parent.right
+			}
+
+			sibling.red, parent.red, sibling.right.red = parent.red, false, false
+			rbt.rotateLeft(parent)
+		} else {
+			sibling := parent.left
+
+			if sibling.isRed() {
+				sibling.red, parent.red = false, true
+				rbt.rotateRight(parent)
+				sibling = parent.left
+			}
+
+			if !sibling.left.isRed() && !sibling.right.isRed() {
+				sibling.red = true
+				node, parent = parent, parent.parent
+				continue
+			}
+
+			if !sibling.left.isRed() {
+				sibling.right.red, sibling.red = false, true
+				rbt.rotateLeft(sibling)
+				sibling = parent.left
+			}
+
+			sibling.red, parent.red, sibling.left.red = parent.red, false, false
+			rbt.rotateRight(parent)
+		}
+
+		node = rbt.root
+	}
+
+	if node != nil {
+		node.red = false
+	}
+}
+
+// Delete deletes the item of the tree. Returns the item deleted and a flag indicating if the item
+// existed in the tree.
+func (rbt *Rbt) Delete(it Item) (Item, bool) {
+	var child, parent *rbNode
+
+	rbt.mutex.Lock()
+	defer rbt.mutex.Unlock()
+
+	node := rbt.search(it)
+	if node == nil {
+		return nil, false
+	}
+
+	// color of the node removed from the tree.
+	removedRed := node.red
+
+	switch {
+	case node.left == nil:
+		child, parent = node.right, node.parent
+		rbt.replaceChild(node.parent, node, node.right)
+
+	case node.right == nil:
+		child, parent = node.left, node.parent
+		rbt.replaceChild(node.parent, node, node.left)
+
+	default:
+		// The node has two children. It is replaced by the next node.
+		next := node.right
+		for next.left != nil {
+			next = next.left
+		}
+
+		removedRed = next.red
+		child = next.right
+
+		if next.parent == node {
+			parent = next
+		} else {
+			parent = next.parent
+			rbt.replaceChild(next.parent, next, next.right)
+			next.right = node.right
+			next.right.parent = next
+		}
+
+		rbt.replaceChild(node.parent, node, next)
+		next.left = node.left
+		next.left.parent = next
+		next.red = node.red
+	}
+
+	if !removedRed {
+		rbt.deleteFixup(child, parent)
+	}
+
+	rbt.length--
+	return node.item, true
+}
+
+// Length returns the number of items in the tree.
+func (rbt *Rbt) Length() int {
+	rbt.mutex.RLock()
+	defer rbt.mutex.RUnlock()
+
+	return rbt.length
+}
+
+// Clear clears the tree.
+func (rbt *Rbt) Clear() {
+	rbt.mutex.Lock()
+	defer rbt.mutex.Unlock()
+
+	rbt.root = nil
+	rbt.length = 0
+}
@@ -0,0 +1,267 @@
+package mygostructs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// checkRbNode checks the red-black properties of the node tree. Returns the black height of the
+// node.
+func checkRbNode(t *testing.T, node *rbNode) int {
+	if node == nil {
+		return 1
+	}
+
+	msg := node.item.String()
+
+	if node.left != nil {
+		assert.True(t, node.left.parent == node, "in node %s: invalid left parent", msg)
+		assert.False(t, node.item.Less(node.left.item), "in node %s: invalid left", msg)
+	}
+
+	if node.right != nil {
+		assert.True(t, node.right.parent == node, "in node %s: invalid right parent", msg)
+		assert.False(t, node.right.item.Less(node.item), "in node %s: invalid right", msg)
+	}
+
+	if node.red {
+		assert.False(t, node.left.isRed(), "in node %s: left child is red", msg)
+		assert.False(t, node.right.isRed(), "in node %s: right child is red", msg)
+	}
+
+	lheight, rheight := checkRbNode(t, node.left), checkRbNode(t, node.right)
+	assert.Equal(t, lheight, rheight, "in node %s: black heights don't match", msg)
+
+	if node.red {
+		return lheight
+	}
+
+	return lheight + 1
+}
+
+// checkRbt checks the red-black properties and the length of the tree.
+func checkRbt(t *testing.T, rbt *Rbt, length int) {
+	assert.Equal(t, rbt.length, length, "tree length doesn't match")
+
+	if rbt.root != nil {
+		assert.False(t, rbt.root.red, "tree root is red")
+		assert.Nil(t, rbt.root.parent, "parent of tree root isn't nil")
+	}
+
+	checkRbNode(t, rbt.root)
+}
+
+func rbtChangeProp(rbt *Rbt, size int, done chan bool) {
+	for i := 0; i < size; i++ {
+		rbt.mutex.Lock()
+		root, length := rbt.root, rbt.length
+		rbt.root, rbt.length = nil, -1
+		time.Sleep(time.Nanosecond)
+		rbt.root, rbt.length = root, length
+		rbt.mutex.Unlock()
+	}
+
+	done <- true
+}
+
+func Test_NewRbt_func(t *testing.T) {
+	rbt := NewRbt()
+
+	assert.Nil(t, rbt.root, "rbt root isn't nil")
+	assert.Equal(t, rbt.length, 0, "rbt length is incorrect")
+	assert.False(t, rbt.duplicated, "rbt duplicated flag is incorrect")
+}
+
+func Test_NewRbtWith_func(t *testing.T) {
+	as := assert.New(t)
+	rbt := NewRbtWith(WithComparator(lastDigit), WithCapacity(3), WithLock(false))
+
+	as.True(rbt.mutex.disabled, "rbt lock isn't disabled")
+	as.True(rbt.Insert(It(1)), "item wasn't inserted")
+	as.False(rbt.Insert(It(11)), "duplicated item was inserted")
+	as.True(rbt.Insert(It(2)), "item wasn't inserted")
+	as.True(rbt.Insert(It(3)), "item wasn't inserted")
+	as.False(rbt.Insert(It(4)), "item was inserted in full tree")
+	checkRbt(t, &rbt, 3)
+
+	item, found := rbt.Search(It(12))
+	as.True(found, "item not found")
+	as.Equal(item, It(2), "item found is incorrect")
+
+	item, _ = rbt.Delete(It(23))
+	as.Equal(item, It(3), "item deleted is incorrect")
+	as.True(rbt.Insert(It(4)), "item wasn't inserted")
+
+	rbt = NewRbtWith(WithDuplicates(true))
+	as.True(rbt.Insert(It(1)), "item wasn't inserted")
+	as.True(rbt.Insert(It(1)), "duplicated item wasn't inserted")
+	checkRbt(t, &rbt, 2)
+}
+
+func Test_Rbt_Insert_func(t *testing.T) {
+	as := assert.New(t)
+	rbt := NewRbt()
+
+	for num := 0; num < 200; num++ {
+		item := It(num * 37 % 200)
+		as.True(rbt.Insert(item), "item %s wasn't inserted", item)
+		checkRbt(t, &rbt, num+1)
+	}
+
+	as.False(rbt.Insert(It(0)), "duplicated item was inserted")
+	checkRbt(t, &rbt, 200)
+
+	// sorted items
+	rbt = NewRbt()
+	for num := 0; num < 200; num++ {
+		rbt.Insert(It(num))
+	}
+	checkRbt(t, &rbt, 200)
+
+	// duplicated items
+	rbt = Rbt{duplicated: true}
+	for num := 0; num < 200; num++ {
+		as.True(rbt.Insert(It(num%10)), "duplicated item wasn't inserted")
+	}
+	checkRbt(t, &rbt, 200)
+}
+
+func Test_Rbt_Insert_func_sync(t *testing.T) {
+	as := assert.New(t)
+	rbt := NewRbt()
+	done := make(chan bool)
+	concurrence := 8
+	size := 2000
+
+	insert := func(min, max int) {
+		for i := min; i < max; i++ {
+			item := It(i)
+			as.Truef(rbt.Insert(item), "item %s is duplicated", item)
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go insert(i*size, (i+1)*size)
+		go rbtChangeProp(&rbt, size, done)
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+		<-done
+	}
+
+	checkRbt(t, &rbt, size*concurrence)
+}
+
+func Test_Rbt_Search_func(t *testing.T) {
+	as := assert.New(t)
+	rbt := NewRbt()
+
+	for i := 0; i < 50; i++ {
+		rbt.Insert(It(i * 2))
+	}
+
+	for i := 0; i < 50; i++ {
+		item := It(i * 2)
+		result, found := rbt.Search(item)
+		as.True(found, "item %s not found", item)
+		as.Truef(result.Eq(item), "item found doesn't match")
+
+		item = It(i*2 + 1)
+		result, found = rbt.Search(item)
+		as.False(found, "item %s found", item)
+		as.Nil(result, "result isn't nil")
+	}
+}
+
+func Test_Rbt_Delete_func(t *testing.T) {
+	as := assert.New(t)
+
+	for _, duplicated := range []bool{false, true} {
+		rbt := Rbt{duplicated: duplicated}
+		length := 0
+
+		for num := 0; num < 300; num++ {
+			if rbt.Insert(It(num * 7 % 150)) {
+				length++
+			}
+		}
+
+		for num := 0; num < 200; num++ {
+			value := num * 11 % 200
+			_, exists := rbt.Search(It(value))
+			item, deleted := rbt.Delete(It(value))
+
+			as.Equal(deleted, exists, "delete result of %d is incorrect", value)
+			if deleted {
+				as.Equal(item.(IntItem).value, value, "item deleted is incorrect")
+				length--
+			}
+
+			checkRbt(t, &rbt, length)
+		}
+	}
+
+	rbt := NewRbt()
+	item, deleted := rbt.Delete(It(1))
+	as.False(deleted, "item deleted in empty tree")
+	as.Nil(item, "item deleted isn't nil")
+}
+
+func Test_Rbt_Delete_func_sync(t *testing.T) {
+	as := assert.New(t)
+	rbt := NewRbt()
+	size := 1000
+	concurrence := 8
+	done := make(chan bool)
+	deleted := func(min, max int) {
+		for i := min; i < max; i++ {
+			value, deleted := rbt.Delete(It(i))
+			as.True(deleted, "item %d wasn't deleted", i)
+			as.Equal(value.(IntItem).value, i, "the value deleted is incorrect")
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < size*concurrence; i++ {
+		rbt.Insert(It(i))
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go deleted(i*size, (i+1)*size)
+		go rbtChangeProp(&rbt, size, done)
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+		<-done
+	}
+
+	checkRbt(t, &rbt, 0)
+}
+
+func Test_Rbt_Length_func(t *testing.T) {
+	rbt := NewRbt()
+
+	for i := 1; i <= 10; i++ {
+		rbt.Insert(It(i))
+		assert.Equalf(t, rbt.Length(), i, "tree length doesn't match")
+	}
+}
+
+func Test_Rbt_Clear_func(t *testing.T) {
+	rbt := Rbt{duplicated: true}
+
+	for i := 1; i <= 10; i++ {
+		rbt.Insert(It(i))
+	}
+
+	rbt.Clear()
+	assert.Nil(t, rbt.root, "tree is empty, but root isn't nil")
+	assert.Equal(t, rbt.length, 0, "tree is empty, but length isn't 0")
+	assert.True(t, rbt.duplicated, "duplicated property is incorrect")
+}
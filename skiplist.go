@@ -0,0 +1,262 @@
+package mygostructs
+
+import (
+	"math/bits"
+	"math/rand"
+	"sync/atomic"
+	"time"
+)
+
+// skipListMaxLevel is the max number of levels of the skip list.
+const skipListMaxLevel = 32
+
+// skipLinks are the links of a skip list node to the next nodes, one for each level.
+type skipLinks []atomic.Pointer[skipNode]
+
+// skipNode is the internal node of the skip list.
+type skipNode struct {
+	next skipLinks // Links to the next nodes.
+	item Item
+}
+
+// SkipList is a struct it implements a skip list type data structure. It is a sorted linked list
+// with several levels of links, where each level skips more items than the level below. The
+// levels of the nodes are random, so the operations are O(log n) with a high probability.
+//
+// The levels are generated by a random source. Two skip lists created with the same seed and with
+// the same operations have the same levels.
+//
+// The struct is adapted to run in multithread code. The operations that modify the list are
+// locked, but the reads (Search, Min, Max, Range...) aren't locked and they run in parallel with
+// the other operations. The reads see each modification as done or not done, never half done.
+type SkipList struct {
+	head       [skipListMaxLevel]atomic.Pointer[skipNode] // Links to the first nodes.
+	level      atomic.Int32                               // Number of levels used.
+	length     atomic.Int64                               // Number of items in the list.
+	duplicated bool                                       // Flag of duplicated items.
+	compare    Comparator                                 // Function for comparing the items.
+	capacity   int                                        // Max number of items, 0 if no limit.
+	rnd        *rand.Rand                                 // Random source of the levels.
+	mutex      rwLock                                     // Lock for the modifications.
+}
+
+// NewSkipList creates an empty skip list. The random source of the list is seeded with the
+// current time.
+func NewSkipList() SkipList {
+	return NewSkipListSeed(time.Now().UnixNano())
+}
+
+// NewSkipListSeed creates an empty skip list whose random source is seeded with the seed of the
+// parameter. The options work as in the NewSkipListWith function.
+func NewSkipListSeed(seed int64, opts ...Option) SkipList {
+	o := newOptions(opts)
+	return SkipList{
+		duplicated: o.duplicated,
+		compare:    o.compare,
+		capacity:   o.capacity,
+		rnd:        rand.New(rand.NewSource(seed)),
+		mutex:      o.lock(),
+	}
+}
+
+// NewSkipListUnsync creates an empty skip list without lock, for using it in only one goroutine.
+// The random source of the list is seeded with the current time.
+func NewSkipListUnsync() SkipList {
+	return NewSkipListWith(WithLock(false))
+}
+
+// NewSkipListWith creates an empty skip list with the options of the parameters. The list uses
+// all options: duplicated items, comparator, lock and capacity. Without lock, the modifications
+// aren't locked and only one goroutine can use the list. The random source of the list is seeded
+// with the current time.
+func NewSkipListWith(opts ...Option) SkipList {
+	return NewSkipListSeed(time.Now().UnixNano(), opts...)
+}
+
+// randomLevel returns a random level for a new node. The level n has probability 1/2^n. If the
+// list doesn't have random source, it creates one seeded with the current time.
+func (sl *SkipList) randomLevel() int {
+	if sl.rnd == nil {
+		sl.rnd = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	level := bits.TrailingZeros64(^uint64(sl.rnd.Int63())) + 1
+	if level > skipListMaxLevel {
+		return skipListMaxLevel
+	}
+
+	return level
+}
+
+// seek searchs the first node whose item isn't before, using the before function. The before
+// function must return true for all items less than an item where it returns true. If prev isn't
+// nil, the function stores in it the links to the node found in each level.
+func (sl *SkipList) seek(before func(Item) bool, prev *[skipListMaxLevel]skipLinks) *skipNode {
+	links := skipLinks(sl.head[:])
+
+	for level := int(sl.level.Load()) - 1; level >= 0; level-- {
+		for next := links[level].Load(); next != nil && before(next.item); {
+			links = next.next
+			next = links[level].Load()
+		}
+
+		if prev != nil {
+			prev[level] = links
+		}
+	}
+
+	return links[0].Load()
+}
+
+// Insert inserts the item in the skip list. The function returns a flag indicating if the
+// operation was success or the item cannot be inserted because it was duplicated or the list is
+// full.
+func (sl *SkipList) Insert(it Item) bool {
+	var prev [skipListMaxLevel]skipLinks
+
+	sl.mutex.Lock()
+	defer sl.mutex.Unlock()
+
+	if isFull(int(sl.length.Load()), sl.capacity) {
+		return false
+	}
+
+	for level := range prev {
+		prev[level] = sl.head[:]
+	}
+
+	if sl.duplicated {
+		// the item is inserted after the equal items.
+		sl.seek(func(nodeItem Item) bool { return !sl.compare.less(it, nodeItem) }, &prev)
+	} else if next := sl.seek(func(nodeItem Item) bool {
+		return sl.compare.less(nodeItem, it)
+	}, &prev); next != nil && sl.compare.eq(next.item, it) {
+		return false
+	}
+
+	node := &skipNode{next: make(skipLinks, sl.randomLevel()), item: it}
+	for level := range node.next {
+		node.next[level].Store(prev[level][level].Load())
+	}
+
+	// the node is linked from the bottom, so the reads never skip it when it is in a level.
+	for level := range node.next {
+		prev[level][level].Store(node)
+	}
+
+	if len(node.next) > int(sl.level.Load()) {
+		sl.level.Store(int32(len(node.next)))
+	}
+
+	sl.length.Add(1)
+	return true
+}
+
+// Search searchs the item in the skip list. It returns the item found and a flag indicating if
+// the item exists in the list.
+func (sl *SkipList) Search(it Item) (Item, bool) {
+	node := sl.seek(func(nodeItem Item) bool { return sl.compare.less(nodeItem, it) }, nil)
+
+	if node != nil && sl.compare.eq(node.item, it) {
+		return node.item, true
+	}
+
+	return nil, false
+}
+
+// Delete deletes the item of the skip list. Returns the item deleted and a flag indicating if the
+// item existed in the list.
+func (sl *SkipList) Delete(it Item) (Item, bool) {
+	var prev [skipListMaxLevel]skipLinks
+
+	sl.mutex.Lock()
+	defer sl.mutex.Unlock()
+
+	node := sl.seek(func(nodeItem Item) bool { return sl.compare.less(nodeItem, it) }, &prev)
+	if node == nil || !sl.compare.eq(node.item, it) {
+		return nil, false
+	}
+
+	// the links of the node aren't modified, so the reads inside of the node can continue.
+	for level := len(node.next) - 1; level >= 0; level-- {
+		prev[level][level].Store(node.next[level].Load())
+	}
+
+	level := sl.level.Load()
+	for level > 0 && sl.head[level-1].Load() == nil {
+		level--
+	}
+
+	sl.level.Store(level)
+	sl.length.Add(-1)
+	return node.item, true
+}
+
+// Min returns the smallest item of the skip list. The second value returned is a flag indicating
+// if the item exists or the list is empty.
+func (sl *SkipList) Min() (Item, bool) {
+	if node := sl.head[0].Load(); node != nil {
+		return node.item, true
+	}
+
+	return nil, false
+}
+
+// Max returns the greatest item of the skip list. The second value returned is a flag indicating
+// if the item exists or the list is empty.
+func (sl *SkipList) Max() (Item, bool) {
+	var node *skipNode
+
+	links := skipLinks(sl.head[:])
+	for level := int(sl.level.Load()) - 1; level >= 0; level-- {
+		for next := links[level].Load(); next != nil; next = links[level].Load() {
+			node = next
+			links = next.next
+		}
+	}
+
+	if node != nil {
+		return node.item, true
+	}
+
+	return nil, false
+}
+
+// Length returns the number of items in the skip list.
+func (sl *SkipList) Length() int {
+	return int(sl.length.Load())
+}
+
+// Clear clears the skip list.
+func (sl *SkipList) Clear() {
+	sl.mutex.Lock()
+	defer sl.mutex.Unlock()
+
+	for level := range sl.head {
+		sl.head[level].Store(nil)
+	}
+
+	sl.level.Store(0)
+	sl.length.Store(0)
+}
+
+// Traverse executes the function of the parameter with the items of the skip list, sorted. The
+// traverse stops when the function returns false. The list isn't locked while the traverse is
+// running, so the function can use the methods of the list.
+func (sl *SkipList) Traverse(f func(Item) bool) {
+	sl.Range(Bounds{}, f)
+}
+
+// Range executes the function of the parameter with the items of the skip list that are inside of
+// the b range, sorted. The iteration stops when the function returns false. The list isn't locked
+// while the iteration is running, so the function can use the methods of the list. The items
+// inserted or deleted during the iteration can be visited or not.
+func (sl *SkipList) Range(b Bounds, f func(Item) bool) {
+	node := sl.seek(func(nodeItem Item) bool { return !b.afterFrom(nodeItem, sl.compare) }, nil)
+
+	for ; node != nil && b.beforeTo(node.item, sl.compare); node = node.next[0].Load() {
+		if !f(node.item) {
+			return
+		}
+	}
+}
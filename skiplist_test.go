@@ -0,0 +1,401 @@
+package mygostructs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// skipListValues returns the values of the skip list items in the level of the parameter.
+func skipListValues(sl *SkipList, level int) []int {
+	values := []int{}
+
+	for node := sl.head[level].Load(); node != nil; node = node.next[level].Load() {
+		values = append(values, node.item.(IntItem).value)
+	}
+
+	return values
+}
+
+// checkSkipList checks the items, the levels and the length of the skip list.
+func checkSkipList(t *testing.T, sl *SkipList, values []int) {
+	as := assert.New(t)
+	as.Equal(skipListValues(sl, 0), values, "items don't match")
+	as.Equal(sl.Length(), len(values), "skip list length doesn't match")
+
+	level := int(sl.level.Load())
+	for l := 0; l < skipListMaxLevel; l++ {
+		if l >= level {
+			as.Nil(sl.head[l].Load(), "level %d is used", l)
+			continue
+		}
+
+		as.NotNil(sl.head[l].Load(), "level %d is empty", l)
+
+		if l == 0 {
+			continue
+		}
+
+		// each level contains a subset of the items of the level below.
+		below := skipListValues(sl, l-1)
+		pos := 0
+		for _, value := range skipListValues(sl, l) {
+			for pos < len(below) && below[pos] != value {
+				pos++
+			}
+
+			as.Less(pos, len(below), "item %d of level %d not found below", value, l)
+		}
+	}
+}
+
+// skipListLevels returns the level of each node of the skip list.
+func skipListLevels(sl *SkipList) []int {
+	levels := []int{}
+
+	for node := sl.head[0].Load(); node != nil; node = node.next[0].Load() {
+		levels = append(levels, len(node.next))
+	}
+
+	return levels
+}
+
+func Test_NewSkipList_func(t *testing.T) {
+	sl := NewSkipList()
+
+	assert.Nil(t, sl.head[0].Load(), "skip list head isn't nil")
+	assert.NotNil(t, sl.rnd, "skip list random source is nil")
+	assert.False(t, sl.duplicated, "skip list duplicated flag is incorrect")
+	assert.Equal(t, sl.Length(), 0, "skip list length isn't 0")
+}
+
+func Test_NewSkipListSeed_func(t *testing.T) {
+	a, b := NewSkipListSeed(42), NewSkipListSeed(42)
+
+	for num := 0; num < 100; num++ {
+		a.Insert(It(num))
+		b.Insert(It(num))
+	}
+
+	assert.Equal(t, skipListLevels(&a), skipListLevels(&b), "skip lists levels are different")
+}
+
+func Test_NewSkipListWith_func(t *testing.T) {
+	as := assert.New(t)
+	sl := NewSkipListWith(WithComparator(reverse), WithCapacity(5), WithLock(false))
+
+	as.NotNil(sl.rnd, "skip list random source is nil")
+	as.True(sl.mutex.disabled, "skip list lock isn't disabled")
+
+	for num := 0; num < 10; num++ {
+		as.Equal(sl.Insert(It(num)), num < 5, "insert of %d is incorrect", num)
+	}
+
+	as.Equal(skipListValues(&sl, 0), []int{4, 3, 2, 1, 0}, "items don't match")
+
+	values := []int{}
+	sl.Range(Bounds{It(3), It(1), true, true}, func(it Item) bool {
+		values = append(values, it.(IntItem).value)
+		return true
+	})
+
+	as.Equal(values, []int{3, 2, 1}, "range items don't match")
+
+	_, found := sl.Search(It(2))
+	as.True(found, "item not found")
+
+	item, _ := sl.Delete(It(2))
+	as.Equal(item, It(2), "item deleted is incorrect")
+	as.Equal(skipListValues(&sl, 0), []int{4, 3, 1, 0}, "items don't match")
+
+	sl = NewSkipListSeed(1, WithDuplicates(true), WithComparator(lastDigit))
+	for num := 0; num < 20; num++ {
+		as.True(sl.Insert(It(num)), "duplicated item wasn't inserted")
+	}
+
+	// the equal items are sorted as they were inserted.
+	as.Equal(skipListValues(&sl, 0)[:4], []int{0, 10, 1, 11}, "items don't match")
+}
+
+func Test_SkipList_randomLevel_func(t *testing.T) {
+	sl := NewSkipListSeed(1)
+	count := make([]int, skipListMaxLevel+1)
+	size := 10000
+
+	for i := 0; i < size; i++ {
+		level := sl.randomLevel()
+		assert.True(t, level >= 1 && level <= skipListMaxLevel, "invalid level %d", level)
+		count[level]++
+	}
+
+	// the half of the nodes has level 1 and a quarter has level 2.
+	assert.InDelta(t, count[1], size/2, float64(size)/20, "level 1 frequency is incorrect")
+	assert.InDelta(t, count[2], size/4, float64(size)/20, "level 2 frequency is incorrect")
+
+	// zero value skip list.
+	sl = SkipList{}
+	assert.GreaterOrEqual(t, sl.randomLevel(), 1, "invalid level")
+	assert.NotNil(t, sl.rnd, "random source wasn't created")
+}
+
+func Test_SkipList_Insert_func(t *testing.T) {
+	as := assert.New(t)
+	sl := NewSkipListSeed(1)
+
+	for num := 0; num < 100; num++ {
+		item := It(num * 37 % 100)
+		as.True(sl.Insert(item), "item %s wasn't inserted", item)
+	}
+
+	as.False(sl.Insert(It(5)), "duplicated item was inserted")
+	checkSkipList(t, &sl, intRange(0, 100))
+
+	// duplicated items
+	sl = SkipList{duplicated: true}
+	for num := 0; num < 6; num++ {
+		as.True(sl.Insert(It(num%3)), "duplicated item wasn't inserted")
+	}
+	checkSkipList(t, &sl, []int{0, 0, 1, 1, 2, 2})
+}
+
+func Test_SkipList_Insert_func_sync(t *testing.T) {
+	as := assert.New(t)
+	sl := NewSkipList()
+	done := make(chan bool)
+	concurrence := 8
+	size := 1000
+
+	insert := func(min, max int) {
+		for i := min; i < max; i++ {
+			item := It(i)
+			as.Truef(sl.Insert(item), "item %s is duplicated", item)
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go insert(i*size, (i+1)*size)
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+	}
+
+	checkSkipList(t, &sl, intRange(0, size*concurrence))
+}
+
+func Test_SkipList_Search_func(t *testing.T) {
+	as := assert.New(t)
+	sl := NewSkipListSeed(1)
+
+	for i := 0; i < 50; i++ {
+		sl.Insert(It(i * 2))
+	}
+
+	for i := 0; i < 50; i++ {
+		item := It(i * 2)
+		result, found := sl.Search(item)
+		as.True(found, "item %s not found", item)
+		as.Truef(result.Eq(item), "item found doesn't match")
+
+		item = It(i*2 + 1)
+		result, found = sl.Search(item)
+		as.False(found, "item %s found", item)
+		as.Nil(result, "result isn't nil")
+	}
+}
+
+func Test_SkipList_Search_func_sync(t *testing.T) {
+	as := assert.New(t)
+	sl := NewSkipList()
+	done := make(chan bool)
+	concurrence := 4
+	size := 1000
+
+	// the even items always exist, the odd items are inserted and deleted while the reads run.
+	for i := 0; i < size; i += 2 {
+		sl.Insert(It(i))
+	}
+
+	search := func() {
+		for i := 0; i < size; i += 2 {
+			_, found := sl.Search(It(i))
+			as.True(found, "item %d not found", i)
+		}
+
+		done <- true
+	}
+
+	modify := func() {
+		for i := 1; i < size; i += 2 {
+			sl.Insert(It(i))
+		}
+
+		for i := 1; i < size; i += 2 {
+			sl.Delete(It(i))
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go search()
+		go modify()
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+		<-done
+	}
+
+	expected := []int{}
+	for i := 0; i < size; i += 2 {
+		expected = append(expected, i)
+	}
+
+	checkSkipList(t, &sl, expected)
+}
+
+func Test_SkipList_Delete_func(t *testing.T) {
+	as := assert.New(t)
+	sl := NewSkipListSeed(1)
+
+	for num := 0; num < 100; num++ {
+		sl.Insert(It(num))
+	}
+
+	expected := []int{}
+	for num := 0; num < 100; num++ {
+		if num%3 != 0 {
+			expected = append(expected, num)
+			continue
+		}
+
+		item, deleted := sl.Delete(It(num))
+		as.True(deleted, "item %d wasn't deleted", num)
+		as.Equal(item.(IntItem).value, num, "item deleted is incorrect")
+	}
+
+	checkSkipList(t, &sl, expected)
+
+	item, deleted := sl.Delete(It(0))
+	as.False(deleted, "item deleted twice")
+	as.Nil(item, "item deleted isn't nil")
+
+	for _, num := range expected {
+		sl.Delete(It(num))
+	}
+
+	checkSkipList(t, &sl, []int{})
+	as.Equal(sl.level.Load(), int32(0), "empty skip list has levels")
+}
+
+func Test_SkipList_MinMax_func(t *testing.T) {
+	as := assert.New(t)
+	sl := NewSkipListSeed(1)
+
+	item, found := sl.Min()
+	as.False(found, "min found in empty skip list")
+	as.Nil(item, "min isn't nil")
+
+	item, found = sl.Max()
+	as.False(found, "max found in empty skip list")
+	as.Nil(item, "max isn't nil")
+
+	for num := 0; num < 100; num++ {
+		sl.Insert(It(num * 37 % 100))
+	}
+
+	item, found = sl.Min()
+	as.True(found, "min not found")
+	as.Equal(item, It(0), "min is incorrect")
+
+	item, found = sl.Max()
+	as.True(found, "max not found")
+	as.Equal(item, It(99), "max is incorrect")
+}
+
+func Test_SkipList_Length_func(t *testing.T) {
+	sl := NewSkipListSeed(1)
+
+	for i := 1; i <= 10; i++ {
+		sl.Insert(It(i))
+		assert.Equalf(t, sl.Length(), i, "skip list length doesn't match")
+	}
+}
+
+func Test_SkipList_Clear_func(t *testing.T) {
+	sl := NewSkipListSeed(1)
+
+	for i := 1; i <= 10; i++ {
+		sl.Insert(It(i))
+	}
+
+	sl.Clear()
+	checkSkipList(t, &sl, []int{})
+	assert.Equal(t, sl.level.Load(), int32(0), "empty skip list has levels")
+}
+
+func Test_SkipList_Traverse_func(t *testing.T) {
+	sl := NewSkipListSeed(1)
+	values := []int{}
+
+	for num := 0; num < 20; num++ {
+		sl.Insert(It(num * 7 % 20))
+	}
+
+	sl.Traverse(func(it Item) bool {
+		values = append(values, it.(IntItem).value)
+		return it.(IntItem).value < 9
+	})
+
+	assert.Equal(t, values, intRange(0, 10), "traverse items don't match")
+
+	// the function can use the methods of the skip list.
+	sl.Traverse(func(it Item) bool {
+		sl.Delete(it)
+		return true
+	})
+
+	checkSkipList(t, &sl, []int{})
+}
+
+func Test_SkipList_Range_func(t *testing.T) {
+	sl := NewSkipListSeed(1)
+
+	for num := 0; num < 20; num++ {
+		sl.Insert(It(num))
+	}
+
+	for from := -1; from <= 21; from++ {
+		for to := from; to <= 21; to++ {
+			for flags := 0; flags < 4; flags++ {
+				b := Bounds{It(from), It(to), flags&1 == 1, flags&2 == 2}
+				values := []int{}
+				expected := []int{}
+
+				sl.Range(b, func(it Item) bool {
+					values = append(values, it.(IntItem).value)
+					return true
+				})
+
+				for num := 0; num < 20; num++ {
+					if b.afterFrom(It(num), nil) && b.beforeTo(It(num), nil) {
+						expected = append(expected, num)
+					}
+				}
+
+				assert.Equal(t, values, expected, "range %v doesn't match", b)
+			}
+		}
+	}
+
+	// without limits and stopped.
+	values := []int{}
+	sl.Range(Bounds{To: It(10)}, func(it Item) bool {
+		values = append(values, it.(IntItem).value)
+		return len(values) < 5
+	})
+
+	assert.Equal(t, values, intRange(0, 5), "range items don't match")
+}
@@ -4,6 +4,9 @@ package mygostructs
 // linearly. It can access and manipulate any item of the list. Also it allows to search quickly
 // items.
 //
+// If the list allows duplicated items, the equal items are sorted by their insertion order: the
+// new item is added after the items equal to it.
+//
 // The struct is adapted to run in multithread code.
 type SortedList struct {
 	list *List
@@ -11,12 +14,25 @@ type SortedList struct {
 
 // NewSortedList creates and returns a new empty sorted list.
 func NewSortedList(duplicated bool) SortedList {
-	list := NewList(duplicated)
+	return NewSortedListWith(WithDuplicates(duplicated))
+}
+
+// NewSortedListUnsync creates and returns a new empty sorted list without lock, for using it in
+// only one goroutine.
+func NewSortedListUnsync(duplicated bool) SortedList {
+	return NewSortedListWith(WithDuplicates(duplicated), WithLock(false))
+}
+
+// NewSortedListWith creates and returns a new empty sorted list with the options of the
+// parameters. The list uses all options: duplicated items, comparator (used for sorting the
+// items), lock and capacity.
+func NewSortedListWith(opts ...Option) SortedList {
+	list := NewListWith(opts...)
 	return SortedList{&list}
 }
 
 // Add adds the item of the paramter to the sorted list. Returns a flag indicating if the item was
-// added successfully.
+// added successfully. The item isn't added if it is duplicated or the list is full.
 func (so *SortedList) Add(item Item) bool {
 	var (
 		prev     *Item
@@ -27,13 +43,20 @@ func (so *SortedList) Add(item Item) bool {
 	so.list.mutex.Lock()
 	defer so.list.mutex.Unlock()
 
-	node = &listNode{item: item}
+	if isFull(so.list.avl.length, so.list.avl.capacity) {
+		return false
+	}
 
+	node = so.list.newNode(item)
+
+	// the tree compares the new node, that isn't in the positional index yet, equal to the
+	// nodes with an equal item, so the previous node is the last of them.
 	so.list.avl.root, prev, inserted = insertGetAdy(
 		so.list.avl.root,
 		node,
 		so.list.avl.rebalance,
-		so.list.avl.duplicated)
+		so.list.avl.duplicated,
+		so.list.avl.compare)
 
 	if !inserted {
 		return false
@@ -66,6 +89,8 @@ func (so *SortedList) Add(item Item) bool {
 		}
 	}
 
+	so.list.indexInsert(node)
+
 	return true
 }
 
@@ -110,17 +135,42 @@ func (so *SortedList) Get() (Item, bool) {
 }
 
 // Search searchs the item in the list. It returns the item found and a flag indicating if the item
-// exists in the list. This function also move the internal pointer to the item found.
+// exists in the list. This function also move the internal pointer to the item found. If the item
+// is duplicated, the first item inserted is returned.
 func (so *SortedList) Search(it Item) (Item, bool) {
 	return so.list.Search(it)
 }
 
+// SearchLast searchs the item in the list, as the Search function. If the item is duplicated, the
+// last item inserted is returned.
+func (so *SortedList) SearchLast(it Item) (Item, bool) {
+	return so.list.SearchLast(it)
+}
+
+// SearchAll returns all items of the list equal to the it item, in insertion order. The internal
+// pointer doesn't move.
+func (so *SortedList) SearchAll(it Item) []Item {
+	return so.list.SearchAll(it)
+}
+
+// CountOf returns the number of items of the list equal to the it item.
+func (so *SortedList) CountOf(it Item) int {
+	return so.list.CountOf(it)
+}
+
 // Delete deletes the item pointed by the internal pointer and it moves the internal pointer to
 // the begining of the list. The second value indicates if the item was deleted.
 func (so *SortedList) Delete() (Item, bool) {
 	return so.list.Delete()
 }
 
+// DeleteAll deletes all items of the list equal to the it item and returns the number of items
+// deleted. If the internal pointer points to an item deleted, it is moved to the begining of the
+// list.
+func (so *SortedList) DeleteAll(it Item) int {
+	return so.list.DeleteAll(it)
+}
+
 // Clear clears the list.
 func (so *SortedList) Clear() {
 	so.list.Clear()
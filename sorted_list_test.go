@@ -20,6 +20,29 @@ func Test_NewSortedList_func(t *testing.T) {
 	}
 }
 
+func Test_NewSortedListWith_func(t *testing.T) {
+	as := assert.New(t)
+	sl := NewSortedListWith(WithComparator(reverse), WithCapacity(5), WithLock(false))
+	values := []int{}
+
+	as.True(sl.list.mutex.disabled, "sorted list lock isn't disabled")
+
+	for _, num := range []int{3, 7, 1, 9, 5, 4} {
+		as.Equal(sl.Add(It(num)), num != 4, "add of %d is incorrect", num)
+	}
+
+	sl.ForEach(func(it Item) {
+		values = append(values, it.(IntItem).value)
+	})
+
+	as.Equal(values, []int{9, 7, 5, 3, 1}, "items don't match")
+
+	sl = NewSortedListWith(WithDuplicates(true))
+	as.True(sl.Add(It(1)), "item wasn't added")
+	as.True(sl.Add(It(1)), "duplicated item wasn't added")
+	as.Equal(sl.Length(), 2, "sorted list length doesn't match")
+}
+
 func Test_SortedList_Add_func(t *testing.T) {
 	as := assert.New(t)
 	list := NewSortedList(false)
@@ -929,3 +952,38 @@ func Test_SortedList_Filter_func(t *testing.T) {
 		"item pointed by internal pointer is diff after execute filter function",
 	)
 }
+
+func Test_SortedList_duplicates_func(t *testing.T) {
+	as := assert.New(t)
+	list := NewSortedListWith(WithDuplicates(true), WithComparator(byHundreds))
+
+	for _, num := range []int{201, 101, 202, 102, 301, 103, 203} {
+		list.Add(It(num))
+	}
+
+	// the equal items keep their insertion order.
+	as.Equal(listItems(list.list), []int{101, 102, 103, 201, 202, 203, 301},
+		"items don't match")
+	checkListTree(t, list.list)
+
+	item, _ := list.Search(It(200))
+	as.Equal(item, It(201), "item found isn't the first inserted")
+	item, _ = list.SearchLast(It(200))
+	as.Equal(item, It(203), "item found isn't the last inserted")
+
+	as.Equal(list.SearchAll(It(100)), []Item{It(101), It(102), It(103)},
+		"items found don't match")
+	as.Equal(list.CountOf(It(100)), 3, "count is incorrect")
+
+	list.Search(It(200))
+	list.Next()
+	item, _ = list.Delete()
+	as.Equal(item, It(202), "item deleted is incorrect")
+
+	as.Equal(list.DeleteAll(It(100)), 3, "number of items deleted is incorrect")
+	as.Equal(listItems(list.list), []int{201, 203, 301}, "items don't match")
+	checkListTree(t, list.list)
+
+	list.Add(It(204))
+	as.Equal(listItems(list.list), []int{201, 203, 204, 301}, "items don't match")
+}
@@ -0,0 +1,215 @@
+package mygostructs
+
+// splayNode is the internal node of the splay tree.
+type splayNode struct {
+	left, right *splayNode
+	item        Item
+}
+
+// splay moves to the root of the node tree the node found following the direction function and
+// returns the new root. The direction function returns a negative number if the node searched is
+// in the left of the item of the parameter, a positive number if it is in the right and 0 if the
+// node has been found. If the node isn't found, the last node visited is moved to the root.
+func splay(node *splayNode, direction func(Item) int) *splayNode {
+	var header splayNode
+
+	if node == nil {
+		return nil
+	}
+
+	// left and right are the last nodes of the trees with the items less and greater than the
+	// item searched.
+	left, right := &header, &header
+
+	for {
+		dir := direction(node.item)
+
+		if dir < 0 {
+			if node.left == nil {
+				break
+			}
+
+			if direction(node.left.item) < 0 {
+				// rotate right
+				child := node.left
+				node.left = child.right
+				child.right = node
+				node = child
+
+				if node.left == nil {
+					break
+				}
+			}
+
+			right.left = node
+			right = node
+			node = node.left
+		} else if dir > 0 {
+			if node.right == nil {
+				break
+			}
+
+			if direction(node.right.item) > 0 {
+				// rotate left
+				child := node.right
+				node.right = child.left
+				child.left = node
+				node = child
+
+				if node.right == nil {
+					break
+				}
+			}
+
+			left.right = node
+			left = node
+			node = node.right
+		} else {
+			break
+		}
+	}
+
+	left.right = node.left
+	right.left = node.right
+	node.left = header.right
+	node.right = header.left
+
+	return node
+}
+
+// splayItem moves to the root of the node tree the node with the it item, or the last node visited
+// searching it, and returns the new root. The items are compared with the cmp comparator.
+func splayItem(node *splayNode, it Item, cmp Comparator) *splayNode {
+	return splay(node, cmp.bind(it).compare)
+}
+
+// Splay is a struct it implements a splay tree type data structure. Each time an item is
+// searched, inserted or deleted, the tree moves the node of the item to the root, so the items
+// accessed recently are found quickly. It is useful when the most of searches access a small set
+// of items.
+//
+// The struct is adapted to run in multithread code. Since the Search method modifies the
+// structure of the tree, the Search method locks the tree exclusively, as Insert and Delete, and
+// the searches executed in different threads are not run in parallel. The Length method doesn't
+// modify the tree, so it only locks it for reading.
+type Splay struct {
+	root       *splayNode // Tree root.
+	length     int        // Number of tree nodes.
+	duplicated bool       // Flag indicating if allows duplicated items.
+	compare    Comparator // Function for comparing the items. If nil, Less and Eq are used.
+	capacity   int        // Max number of items. If it is 0, the number isn't limited.
+	mutex      rwLock     // Lock for avoid the concurrence when manipulate the struct.
+}
+
+// NewSplay creates an empty splay tree.
+func NewSplay() Splay {
+	return NewSplayWith()
+}
+
+// NewSplayUnsync creates an empty splay tree without lock, for using it in only one goroutine.
+func NewSplayUnsync() Splay {
+	return NewSplayWith(WithLock(false))
+}
+
+// NewSplayWith creates an empty splay tree with the options of the parameters. The tree uses all
+// options: duplicated items, comparator, lock and capacity.
+func NewSplayWith(opts ...Option) Splay {
+	o := newOptions(opts)
+	return Splay{
+		duplicated: o.duplicated,
+		compare:    o.compare,
+		capacity:   o.capacity,
+		mutex:      o.lock(),
+	}
+}
+
+// Insert inserts the item in the tree and moves it to the root. The function returns a flag
+// indicating if the operation was success or the item cannot be inserted because it was
+// duplicated or the tree is full.
+func (sp *Splay) Insert(it Item) bool {
+	sp.mutex.Lock()
+	defer sp.mutex.Unlock()
+
+	if isFull(sp.length, sp.capacity) {
+		return false
+	}
+
+	node := &splayNode{item: it}
+
+	if sp.root != nil {
+		sp.root = splayItem(sp.root, it, sp.compare)
+
+		if !sp.duplicated && sp.compare.eq(sp.root.item, it) {
+			return false
+		}
+
+		if sp.compare.less(it, sp.root.item) {
+			node.left, node.right = sp.root.left, sp.root
+			sp.root.left = nil
+		} else {
+			node.left, node.right = sp.root, sp.root.right
+			sp.root.right = nil
+		}
+	}
+
+	sp.root = node
+	sp.length++
+	return true
+}
+
+// Search searchs the item in the tree and moves it to the root. It returns the item found and a
+// flag indicating if the item exists in the tree.
+func (sp *Splay) Search(it Item) (Item, bool) {
+	sp.mutex.Lock()
+	defer sp.mutex.Unlock()
+
+	sp.root = splayItem(sp.root, it, sp.compare)
+	if sp.root != nil && sp.compare.eq(sp.root.item, it) {
+		return sp.root.item, true
+	}
+
+	return nil, false
+}
+
+// Delete deletes the item of the tree. Returns the item deleted and a flag indicating if the item
+// existed in the tree.
+func (sp *Splay) Delete(it Item) (Item, bool) {
+	sp.mutex.Lock()
+	defer sp.mutex.Unlock()
+
+	sp.root = splayItem(sp.root, it, sp.compare)
+	if sp.root == nil || !sp.compare.eq(sp.root.item, it) {
+		return nil, false
+	}
+
+	node := sp.root
+	if node.left == nil {
+		sp.root = node.right
+	} else {
+		// move the greatest item of the left subtree to the root of the left subtree.
+		sp.root = splay(node.left, func(Item) int {
+			return 1
+		})
+		sp.root.right = node.right
+	}
+
+	sp.length--
+	return node.item, true
+}
+
+// Length returns the number of items in the tree.
+func (sp *Splay) Length() int {
+	sp.mutex.RLock()
+	defer sp.mutex.RUnlock()
+
+	return sp.length
+}
+
+// Clear clears the tree.
+func (sp *Splay) Clear() {
+	sp.mutex.Lock()
+	defer sp.mutex.Unlock()
+
+	sp.root = nil
+	sp.length = 0
+}
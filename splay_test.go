@@ -0,0 +1,258 @@
+package mygostructs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// splayValues returns the values of the splay tree items, sorted. It also checks the order of
+// the items.
+func splayValues(t *testing.T, sp *Splay) []int {
+	var walkNode func(*splayNode)
+
+	values := []int{}
+	walkNode = func(node *splayNode) {
+		if node == nil {
+			return
+		}
+
+		walkNode(node.left)
+		values = append(values, node.item.(IntItem).value)
+		walkNode(node.right)
+	}
+
+	walkNode(sp.root)
+
+	for i := 1; i < len(values); i++ {
+		assert.LessOrEqual(t, values[i-1], values[i], "items aren't sorted")
+	}
+
+	assert.Equal(t, sp.length, len(values), "length doesn't match")
+	return values
+}
+
+func splayChangeProp(sp *Splay, size int, done chan bool) {
+	for i := 0; i < size; i++ {
+		sp.mutex.Lock()
+		root, length := sp.root, sp.length
+		sp.root, sp.length = nil, -1
+		time.Sleep(time.Nanosecond)
+		sp.root, sp.length = root, length
+		sp.mutex.Unlock()
+	}
+
+	done <- true
+}
+
+func Test_NewSplay_func(t *testing.T) {
+	sp := NewSplay()
+
+	assert.Nil(t, sp.root, "splay root isn't nil")
+	assert.Equal(t, sp.length, 0, "splay length is incorrect")
+	assert.False(t, sp.duplicated, "splay duplicated flag is incorrect")
+}
+
+func Test_NewSplayWith_func(t *testing.T) {
+	as := assert.New(t)
+	sp := NewSplayWith(WithComparator(lastDigit), WithCapacity(3), WithLock(false))
+
+	as.True(sp.mutex.disabled, "splay tree lock isn't disabled")
+	as.True(sp.Insert(It(1)), "item wasn't inserted")
+	as.False(sp.Insert(It(11)), "duplicated item was inserted")
+	as.True(sp.Insert(It(2)), "item wasn't inserted")
+	as.True(sp.Insert(It(3)), "item wasn't inserted")
+	as.False(sp.Insert(It(4)), "item was inserted in full tree")
+	as.Equal(splayValues(t, &sp), []int{1, 2, 3}, "items don't match")
+
+	item, found := sp.Search(It(12))
+	as.True(found, "item not found")
+	as.Equal(item, It(2), "item found is incorrect")
+
+	item, _ = sp.Delete(It(23))
+	as.Equal(item, It(3), "item deleted is incorrect")
+
+	sp = NewSplayWith(WithDuplicates(true))
+	as.True(sp.Insert(It(1)), "item wasn't inserted")
+	as.True(sp.Insert(It(1)), "duplicated item wasn't inserted")
+	as.Equal(splayValues(t, &sp), []int{1, 1}, "items don't match")
+}
+
+func Test_Splay_Insert_func(t *testing.T) {
+	as := assert.New(t)
+	sp := NewSplay()
+
+	for num := 0; num < 100; num++ {
+		item := It(num * 37 % 100)
+		as.True(sp.Insert(item), "item %s wasn't inserted", item)
+		as.Equal(sp.root.item, item, "item inserted isn't the root")
+	}
+
+	as.False(sp.Insert(It(5)), "duplicated item was inserted")
+	as.Equal(splayValues(t, &sp), intRange(0, 100), "items don't match")
+
+	// duplicated items
+	sp = Splay{duplicated: true}
+	for num := 0; num < 6; num++ {
+		as.True(sp.Insert(It(num%3)), "duplicated item wasn't inserted")
+	}
+	as.Equal(splayValues(t, &sp), []int{0, 0, 1, 1, 2, 2}, "items don't match")
+}
+
+func Test_Splay_Insert_func_sync(t *testing.T) {
+	as := assert.New(t)
+	sp := NewSplay()
+	done := make(chan bool)
+	concurrence := 8
+	size := 1000
+
+	insert := func(min, max int) {
+		for i := min; i < max; i++ {
+			item := It(i)
+			as.Truef(sp.Insert(item), "item %s is duplicated", item)
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go insert(i*size, (i+1)*size)
+		go splayChangeProp(&sp, size, done)
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+		<-done
+	}
+
+	as.Equal(splayValues(t, &sp), intRange(0, size*concurrence), "items don't match")
+}
+
+func Test_Splay_Search_func(t *testing.T) {
+	as := assert.New(t)
+	sp := NewSplay()
+	expected := []int{}
+
+	for i := 0; i < 50; i++ {
+		sp.Insert(It(i * 2))
+		expected = append(expected, i*2)
+	}
+
+	for i := 0; i < 50; i++ {
+		item := It(i * 2)
+		result, found := sp.Search(item)
+		as.True(found, "item %s not found", item)
+		as.Truef(result.Eq(item), "item found doesn't match")
+		as.Equal(sp.root.item, item, "item found isn't the root")
+
+		item = It(i*2 + 1)
+		result, found = sp.Search(item)
+		as.False(found, "item %s found", item)
+		as.Nil(result, "result isn't nil")
+	}
+
+	as.Equal(splayValues(t, &sp), expected, "items changed")
+
+	sp = NewSplay()
+	_, found := sp.Search(It(1))
+	as.False(found, "item found in empty tree")
+}
+
+func Test_Splay_Search_func_sync(t *testing.T) {
+	as := assert.New(t)
+	sp := NewSplay()
+	size := 1000
+	concurrence := 8
+	done := make(chan bool)
+
+	search := func(min, max int) {
+		for i := min; i < max; i++ {
+			item := It(i)
+			_, found := sp.Search(item)
+			as.True(found, "item %s not found", item)
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < size*concurrence; i++ {
+		sp.Insert(It(i))
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go search(i*size, (i+1)*size)
+		go splayChangeProp(&sp, size, done)
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+		<-done
+	}
+
+	as.Equal(splayValues(t, &sp), intRange(0, size*concurrence), "items don't match")
+}
+
+func Test_Splay_Delete_func(t *testing.T) {
+	as := assert.New(t)
+
+	for _, duplicated := range []bool{false, true} {
+		sp := Splay{duplicated: duplicated}
+		expected := []int{}
+
+		for num := 0; num < 100; num++ {
+			sp.Insert(It(num))
+			if duplicated {
+				sp.Insert(It(num))
+			}
+		}
+
+		for num := 0; num < 100; num++ {
+			if num%3 != 0 {
+				expected = append(expected, num)
+				if duplicated {
+					expected = append(expected, num)
+				}
+				continue
+			}
+
+			item, deleted := sp.Delete(It(num))
+			as.True(deleted, "item %d wasn't deleted", num)
+			as.Equal(item.(IntItem).value, num, "item deleted is incorrect")
+
+			if duplicated {
+				_, deleted = sp.Delete(It(num))
+				as.True(deleted, "duplicated item %d wasn't deleted", num)
+			}
+		}
+
+		as.Equal(splayValues(t, &sp), expected, "items don't match")
+
+		item, deleted := sp.Delete(It(0))
+		as.False(deleted, "item deleted twice")
+		as.Nil(item, "item deleted isn't nil")
+	}
+}
+
+func Test_Splay_Length_func(t *testing.T) {
+	sp := NewSplay()
+
+	for i := 1; i <= 10; i++ {
+		sp.Insert(It(i))
+		assert.Equalf(t, sp.Length(), i, "tree length doesn't match")
+	}
+
+	checkSharedRead(t, &sp.mutex, "Length", func() { sp.Length() })
+}
+
+func Test_Splay_Clear_func(t *testing.T) {
+	sp := Splay{duplicated: true}
+
+	for i := 1; i <= 10; i++ {
+		sp.Insert(It(i))
+	}
+
+	sp.Clear()
+	assert.Nil(t, sp.root, "tree is empty, but root isn't nil")
+	assert.Equal(t, sp.length, 0, "tree is empty, but length isn't 0")
+	assert.True(t, sp.duplicated, "duplicated property is incorrect")
+}
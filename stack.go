@@ -1,7 +1,5 @@
 package mygostructs
 
-import "sync"
-
 //stackNode is the node for Stack struct.
 type stackNode struct {
 	item Item
@@ -11,27 +9,53 @@ type stackNode struct {
 // Stack is a struct it implements a stack type abstract data structure, where the items are
 // inserted linearly and the last item in enter is the first in out. (LIFO)
 //
-// The struct is adapted to run in multithread code.
+// The struct is adapted to run in multithread code. Top and Length only take the read lock, so
+// they run in parallel.
 type Stack struct {
-	top    *stackNode
-	length int
-	mutex  sync.Mutex
+	top      *stackNode
+	length   int
+	capacity int // Max number of items. If it is 0, the number isn't limited.
+	mutex    rwLock
 }
 
 // NewStack creates and returns a new empty stack
 func NewStack() Stack {
-	return Stack{}
+	return NewStackWith()
+}
+
+// NewStackUnsync creates and returns a new empty stack without lock, for using it in only one
+// goroutine.
+func NewStackUnsync() Stack {
+	return NewStackWith(WithLock(false))
 }
 
-// Push inserts a the item to top of the stack
+// NewStackWith creates and returns a new empty stack with the options of the parameters. The stack
+// uses the lock and capacity options, the rest of options are ignored.
+func NewStackWith(opts ...Option) Stack {
+	o := newOptions(opts)
+	return Stack{capacity: o.capacity, mutex: o.lock()}
+}
+
+// Push inserts a the item to top of the stack. If the stack is full, the item isn't inserted.
 func (st *Stack) Push(it Item) {
+	st.TryPush(it)
+}
+
+// TryPush inserts the item to top of the stack, as the Push function. Returns a flag indicating if
+// the item was inserted or the stack is full.
+func (st *Stack) TryPush(it Item) bool {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
 
+	if isFull(st.length, st.capacity) {
+		return false
+	}
+
 	node := &stackNode{item: it}
 	node.prev = st.top
 	st.top = node
 	st.length++
+	return true
 }
 
 // Pop deletes and returns the item in the top of the stack. If the second argment returned is
@@ -54,8 +78,8 @@ func (st *Stack) Pop() (Item, bool) {
 // Top reads the top item in the stack. The second value returned is false if the stack is
 // empty.
 func (st *Stack) Top() (Item, bool) {
-	st.mutex.Lock()
-	defer st.mutex.Unlock()
+	st.mutex.RLock()
+	defer st.mutex.RUnlock()
 
 	if st.length > 0 {
 		return st.top.item, true
@@ -66,8 +90,8 @@ func (st *Stack) Top() (Item, bool) {
 
 // Length returns the number of items in the stack.
 func (st *Stack) Length() int {
-	st.mutex.Lock()
-	defer st.mutex.Unlock()
+	st.mutex.RLock()
+	defer st.mutex.RUnlock()
 
 	return st.length
 }
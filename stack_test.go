@@ -47,6 +47,29 @@ func Test_NewStack_func(t *testing.T) {
 	assert.Equal(t, st.length, 0, "stack length isn't 0 when stack is empty")
 }
 
+func Test_NewStackWith_func(t *testing.T) {
+	as := assert.New(t)
+	st := NewStackWith(WithCapacity(3), WithLock(false))
+
+	as.True(st.mutex.disabled, "stack lock isn't disabled")
+
+	for num := 1; num <= 5; num++ {
+		as.Equal(st.TryPush(It(num)), num <= 3, "push of %d is incorrect", num)
+	}
+
+	checkStackNode(t, st.top, 3, i(2))
+	as.Equal(st.Length(), 3, "stack length doesn't match")
+
+	st.Pop()
+	as.True(st.TryPush(It(4)), "item wasn't pushed")
+
+	// Push discards the item when the stack is full.
+	st.Push(It(5))
+	st.Push(It(6))
+	checkStackNode(t, st.top, 4, i(2))
+	checkStackNode(t, st.top, 4, i(2))
+}
+
 func Test_Stack_Push_func(t *testing.T) {
 	st := NewStack()
 
@@ -272,3 +295,11 @@ func Test_Clear_Stack_func(t *testing.T) {
 	assert.Nil(t, st.top)
 	assert.Equal(t, st.length, 0)
 }
+
+func Test_Stack_reads_shared(t *testing.T) {
+	stack := NewStack()
+	stack.Push(It(1))
+
+	checkSharedRead(t, &stack.mutex, "Top", func() { stack.Top() })
+	checkSharedRead(t, &stack.mutex, "Length", func() { stack.Length() })
+}
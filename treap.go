@@ -0,0 +1,336 @@
+package mygostructs
+
+import (
+	"math/rand"
+	"time"
+)
+
+// treapNode is the internal node of the treap.
+type treapNode struct {
+	left, right *treapNode
+	priority    int64 // Random priority. The parent priority is greater than the children ones.
+	size        int   // Number of nodes of the tree whose root is this node.
+	item        Item
+}
+
+// getSize returns the `node.size` property. If node is nil, then returns 0
+func (node *treapNode) getSize() int {
+	if node != nil {
+		return node.size
+	}
+
+	return 0
+}
+
+// updateSize calculates the `node.size` property using the size of its children.
+func (node *treapNode) updateSize() {
+	node.size = node.left.getSize() + node.right.getSize() + 1
+}
+
+// splitTreap splits the node treap in two treaps. The first treap contains the items where the
+// toLeft function returns true and the second treap contains the rest of items. The toLeft
+// function must return true for all items less than an item where it returns true.
+func splitTreap(node *treapNode, toLeft func(Item) bool) (*treapNode, *treapNode) {
+	var left, right *treapNode
+
+	if node == nil {
+		return nil, nil
+	}
+
+	if toLeft(node.item) {
+		left, right = splitTreap(node.right, toLeft)
+		node.right = left
+		node.updateSize()
+		return node, right
+	}
+
+	left, right = splitTreap(node.left, toLeft)
+	node.left = right
+	node.updateSize()
+	return left, node
+}
+
+// mergeTreaps merges the left treap and the right treap in a new treap and returns it. All items
+// of the left treap must be less than or equal to the items of the right treap.
+func mergeTreaps(left, right *treapNode) *treapNode {
+	if left == nil {
+		return right
+	}
+
+	if right == nil {
+		return left
+	}
+
+	if left.priority > right.priority {
+		left.right = mergeTreaps(left.right, right)
+		left.updateSize()
+		return left
+	}
+
+	right.left = mergeTreaps(left, right.left)
+	right.updateSize()
+	return right
+}
+
+// deleteTreap searchs the item in the node treap and deletes it, comparing the items with the cmp
+// comparator. The function returns the node treap without the item, the item deleted and a flag
+// indicating if the item existed.
+func deleteTreap(node *treapNode, it Item, cmp Comparator) (*treapNode, Item, bool) {
+	var (
+		found     bool
+		itDeleted Item
+	)
+
+	if node == nil {
+		return nil, nil, false
+	}
+
+	c := cmp.compare(it, node.item)
+	if c == 0 {
+		return mergeTreaps(node.left, node.right), node.item, true
+	}
+
+	if c > 0 {
+		node.right, itDeleted, found = deleteTreap(node.right, it, cmp)
+	} else {
+		node.left, itDeleted, found = deleteTreap(node.left, it, cmp)
+	}
+
+	if found {
+		node.size--
+	}
+
+	return node, itDeleted, found
+}
+
+// Treap is a struct it implements a treap type data structure. It is a binary search tree where
+// each node has a random priority and the nodes are sorted as a heap by its priority. The tree
+// is balanced with a high probability, without storing the height of the nodes, and it can be
+// split and merged quickly.
+//
+// The random priorities are generated by a random source. Two treaps created with the same seed
+// and with the same operations have the same shape.
+//
+// The struct is adapted to run in multithread code.
+type Treap struct {
+	root       *treapNode // Treap root.
+	duplicated bool       // Flag indicating if allows duplicated items.
+	compare    Comparator // Function for comparing the items. If nil, Less and Eq are used.
+	capacity   int        // Max number of items. If it is 0, the number isn't limited.
+	rnd        *rand.Rand // Random source used for generating the priorities.
+	mutex      rwLock     // Lock for avoid the concurrence when manipulate the struct.
+}
+
+// NewTreap creates an empty treap. The random source of the treap is seeded with the current time.
+func NewTreap() Treap {
+	return NewTreapSeed(time.Now().UnixNano())
+}
+
+// NewTreapSeed creates an empty treap whose random source is seeded with the seed of the
+// parameter. The options work as in the NewTreapWith function.
+func NewTreapSeed(seed int64, opts ...Option) Treap {
+	o := newOptions(opts)
+	return Treap{
+		duplicated: o.duplicated,
+		compare:    o.compare,
+		capacity:   o.capacity,
+		rnd:        rand.New(rand.NewSource(seed)),
+		mutex:      o.lock(),
+	}
+}
+
+// NewTreapUnsync creates an empty treap without lock, for using it in only one goroutine. The
+// random source of the treap is seeded with the current time.
+func NewTreapUnsync() Treap {
+	return NewTreapWith(WithLock(false))
+}
+
+// NewTreapWith creates an empty treap with the options of the parameters. The treap uses all
+// options: duplicated items, comparator, lock and capacity. The random source of the treap is
+// seeded with the current time.
+func NewTreapWith(opts ...Option) Treap {
+	return NewTreapSeed(time.Now().UnixNano(), opts...)
+}
+
+// random returns the random source of the treap. If the treap doesn't have random source, it
+// creates one seeded with the current time.
+func (tp *Treap) random() *rand.Rand {
+	if tp.rnd == nil {
+		tp.rnd = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return tp.rnd
+}
+
+// Insert inserts the item in the treap. The function returns a flag indicating if the operation
+// was success or the item cannot be inserted because it was duplicated or the treap is full.
+func (tp *Treap) Insert(it Item) bool {
+	tp.mutex.Lock()
+	defer tp.mutex.Unlock()
+
+	if isFull(tp.root.getSize(), tp.capacity) || !tp.duplicated && tp.search(it) != nil {
+		return false
+	}
+
+	node := &treapNode{priority: tp.random().Int63(), size: 1, item: it}
+	left, right := splitTreap(tp.root, func(nodeItem Item) bool {
+		return !tp.compare.less(it, nodeItem)
+	})
+
+	tp.root = mergeTreaps(mergeTreaps(left, node), right)
+	return true
+}
+
+// search searchs the item in the treap. Returns the node that contains the item or nil if the
+// item isn't found.
+func (tp *Treap) search(it Item) *treapNode {
+	bc := tp.compare.bind(it)
+	for node := tp.root; node != nil; {
+		c := bc.compare(node.item)
+		if c == 0 {
+			return node
+		}
+
+		if c > 0 {
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+
+	return nil
+}
+
+// Search searchs the item in the treap. It returns the item found and a flag indicating if the
+// item exists in the treap.
+func (tp *Treap) Search(it Item) (Item, bool) {
+	tp.mutex.RLock()
+	defer tp.mutex.RUnlock()
+
+	if node := tp.search(it); node != nil {
+		return node.item, true
+	}
+
+	return nil, false
+}
+
+// Delete deletes the item of the treap. Returns the item deleted and a flag indicating if the
+// item existed in the treap.
+func (tp *Treap) Delete(it Item) (itd Item, deleted bool) {
+	tp.mutex.Lock()
+	defer tp.mutex.Unlock()
+
+	tp.root, itd, deleted = deleteTreap(tp.root, it, tp.compare)
+	return
+}
+
+// Length returns the number of items in the treap.
+func (tp *Treap) Length() int {
+	tp.mutex.RLock()
+	defer tp.mutex.RUnlock()
+
+	return tp.root.getSize()
+}
+
+// Clear clears the treap.
+func (tp *Treap) Clear() {
+	tp.mutex.Lock()
+	defer tp.mutex.Unlock()
+
+	tp.root = nil
+}
+
+// Split moves the items of the treap to two new treaps and returns them. The first treap
+// contains the items less than the key and the second treap contains the items greater than or
+// equal to the key. The new treaps have the settings of the treap, and their random sources are
+// seeded using the random source of the treap. The treap is empty after the operation.
+func (tp *Treap) Split(key Item) (*Treap, *Treap) {
+	tp.mutex.Lock()
+	defer tp.mutex.Unlock()
+
+	left, right := splitTreap(tp.root, func(it Item) bool {
+		return tp.compare.less(it, key)
+	})
+
+	tp.root = nil
+	return tp.newTreap(left), tp.newTreap(right)
+}
+
+// newTreap creates a treap with the nodes of the root treap and the settings of the tp treap. The
+// random source of the new treap is seeded using the random source of the tp treap.
+func (tp *Treap) newTreap(root *treapNode) *Treap {
+	return &Treap{
+		root:       root,
+		duplicated: tp.duplicated,
+		compare:    tp.compare,
+		capacity:   tp.capacity,
+		rnd:        rand.New(rand.NewSource(tp.random().Int63())),
+		mutex:      rwLock{disabled: tp.mutex.disabled},
+	}
+}
+
+// Merge moves all items of the other treap to the treap. The items of the other treap must be all
+// less or all greater than the items of the treap. If the treap allows duplicated items, the items
+// in the limit can be equal. The other treap is empty after the operation. Returns a flag
+// indicating if the treaps were merged. The treaps aren't merged if the items overlap, the other
+// treap allows duplicated items and the treap doesn't, or the treap doesn't have capacity for the
+// items of the other treap. The items are compared with the comparator of the treap.
+func (tp *Treap) Merge(other *Treap) bool {
+	if tp == other {
+		return false
+	}
+
+	unlock := lockPair(&tp.mutex, &other.mutex, false)
+	defer unlock()
+
+	length := tp.root.getSize() + other.root.getSize()
+	if other.duplicated && !tp.duplicated || tp.capacity > 0 && length > tp.capacity {
+		return false
+	}
+
+	// before checks if the a item can be before of the b item in the treap.
+	before := func(a, b Item) bool {
+		if tp.duplicated {
+			return !tp.compare.less(b, a)
+		}
+
+		return tp.compare.less(a, b)
+	}
+
+	switch {
+	case tp.root == nil || other.root == nil:
+		tp.root = mergeTreaps(tp.root, other.root)
+
+	case before(tp.max().item, other.min().item):
+		tp.root = mergeTreaps(tp.root, other.root)
+
+	case before(other.max().item, tp.min().item):
+		tp.root = mergeTreaps(other.root, tp.root)
+
+	default:
+		return false
+	}
+
+	other.root = nil
+	return true
+}
+
+// min returns the node with the smallest item of the treap. The treap cannot be empty.
+func (tp *Treap) min() *treapNode {
+	node := tp.root
+	for node.left != nil {
+		node = node.left
+	}
+
+	return node
+}
+
+// max returns the node with the greatest item of the treap. The treap cannot be empty.
+func (tp *Treap) max() *treapNode {
+	node := tp.root
+	for node.right != nil {
+		node = node.right
+	}
+
+	return node
+}
@@ -0,0 +1,330 @@
+package mygostructs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// checkTreapNode checks the order, the priorities and the sizes of the node treap. Returns the
+// node size.
+func checkTreapNode(t *testing.T, node *treapNode) int {
+	if node == nil {
+		return 0
+	}
+
+	msg := node.item.String()
+
+	if node.left != nil {
+		assert.False(t, node.item.Less(node.left.item), "in node %s: invalid left", msg)
+		assert.GreaterOrEqual(
+			t,
+			node.priority,
+			node.left.priority,
+			"in node %s: invalid left priority",
+			msg,
+		)
+	}
+
+	if node.right != nil {
+		assert.False(t, node.right.item.Less(node.item), "in node %s: invalid right", msg)
+		assert.GreaterOrEqual(
+			t,
+			node.priority,
+			node.right.priority,
+			"in node %s: invalid right priority",
+			msg,
+		)
+	}
+
+	size := checkTreapNode(t, node.left) + checkTreapNode(t, node.right) + 1
+	assert.Equal(t, node.size, size, "in node %s: the size doesn't match", msg)
+	return size
+}
+
+// treapValues returns the values of the treap items, sorted.
+func treapValues(tp *Treap) []int {
+	var walkNode func(*treapNode)
+
+	values := []int{}
+	walkNode = func(node *treapNode) {
+		if node != nil {
+			walkNode(node.left)
+			values = append(values, node.item.(IntItem).value)
+			walkNode(node.right)
+		}
+	}
+
+	walkNode(tp.root)
+	return values
+}
+
+// checkTreap checks the items and the properties of the treap.
+func checkTreap(t *testing.T, tp *Treap, values []int) {
+	assert.Equal(t, treapValues(tp), values, "items don't match")
+	checkTreapNode(t, tp.root)
+}
+
+// sameTreapShape checks if the a and b nodes have the same shape and items.
+func sameTreapShape(a, b *treapNode) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.item.Eq(b.item) &&
+		a.priority == b.priority &&
+		sameTreapShape(a.left, b.left) &&
+		sameTreapShape(a.right, b.right)
+}
+
+func treapChangeProp(tp *Treap, size int, done chan bool) {
+	for i := 0; i < size; i++ {
+		tp.mutex.Lock()
+		root := tp.root
+		tp.root = nil
+		time.Sleep(time.Nanosecond)
+		tp.root = root
+		tp.mutex.Unlock()
+	}
+
+	done <- true
+}
+
+func Test_NewTreap_func(t *testing.T) {
+	tp := NewTreap()
+
+	assert.Nil(t, tp.root, "treap root isn't nil")
+	assert.NotNil(t, tp.rnd, "treap random source is nil")
+	assert.False(t, tp.duplicated, "treap duplicated flag is incorrect")
+}
+
+func Test_NewTreapSeed_func(t *testing.T) {
+	a, b := NewTreapSeed(42), NewTreapSeed(42)
+
+	for num := 0; num < 100; num++ {
+		a.Insert(It(num))
+		b.Insert(It(num))
+	}
+
+	assert.True(t, sameTreapShape(a.root, b.root), "treaps with the same seed are different")
+}
+
+func Test_NewTreapWith_func(t *testing.T) {
+	as := assert.New(t)
+	tp := NewTreapWith(WithComparator(reverse), WithCapacity(5), WithLock(false))
+
+	as.NotNil(tp.rnd, "treap random source is nil")
+	as.True(tp.mutex.disabled, "treap lock isn't disabled")
+
+	for num := 0; num < 10; num++ {
+		as.Equal(tp.Insert(It(num)), num < 5, "insert of %d is incorrect", num)
+	}
+
+	as.Equal(treapValues(&tp), []int{4, 3, 2, 1, 0}, "items don't match")
+
+	item, _ := tp.Delete(It(2))
+	as.Equal(item, It(2), "item deleted is incorrect")
+
+	// the treaps created by the treap use its settings.
+	left, right := tp.Split(It(1))
+	as.Equal(treapValues(left), []int{4, 3}, "left items don't match")
+	as.Equal(treapValues(right), []int{1, 0}, "right items don't match")
+	as.True(right.mutex.disabled, "treap lock isn't disabled")
+	as.True(left.Merge(right), "treaps weren't merged")
+	as.Equal(treapValues(left), []int{4, 3, 1, 0}, "items don't match")
+
+	left.Insert(It(7))
+	as.False(left.Insert(It(8)), "item was inserted in full treap")
+
+	// the seed and the options
+	a, b := NewTreapSeed(42, WithDuplicates(true)), NewTreapSeed(42, WithDuplicates(true))
+	for num := 0; num < 100; num++ {
+		a.Insert(It(num % 10))
+		b.Insert(It(num % 10))
+	}
+
+	checkTreapNode(t, a.root)
+	as.Equal(a.Length(), 100, "duplicated items weren't inserted")
+	as.True(sameTreapShape(a.root, b.root), "treaps with the same seed are different")
+}
+
+func Test_Treap_Insert_func(t *testing.T) {
+	as := assert.New(t)
+	tp := NewTreapSeed(1)
+
+	for num := 0; num < 100; num++ {
+		item := It(num * 37 % 100)
+		as.True(tp.Insert(item), "item %s wasn't inserted", item)
+	}
+
+	as.False(tp.Insert(It(5)), "duplicated item was inserted")
+	checkTreap(t, &tp, intRange(0, 100))
+
+	// duplicated items
+	tp = Treap{duplicated: true}
+	for num := 0; num < 6; num++ {
+		as.True(tp.Insert(It(num%3)), "duplicated item wasn't inserted")
+	}
+	checkTreap(t, &tp, []int{0, 0, 1, 1, 2, 2})
+}
+
+func Test_Treap_Insert_func_sync(t *testing.T) {
+	as := assert.New(t)
+	tp := NewTreap()
+	done := make(chan bool)
+	concurrence := 8
+	size := 1000
+
+	insert := func(min, max int) {
+		for i := min; i < max; i++ {
+			item := It(i)
+			as.Truef(tp.Insert(item), "item %s is duplicated", item)
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go insert(i*size, (i+1)*size)
+		go treapChangeProp(&tp, size, done)
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+		<-done
+	}
+
+	checkTreap(t, &tp, intRange(0, size*concurrence))
+}
+
+func Test_Treap_Search_func(t *testing.T) {
+	as := assert.New(t)
+	tp := NewTreapSeed(1)
+
+	for i := 0; i < 50; i++ {
+		tp.Insert(It(i * 2))
+	}
+
+	for i := 0; i < 50; i++ {
+		item := It(i * 2)
+		result, found := tp.Search(item)
+		as.True(found, "item %s not found", item)
+		as.Truef(result.Eq(item), "item found doesn't match")
+
+		item = It(i*2 + 1)
+		result, found = tp.Search(item)
+		as.False(found, "item %s found", item)
+		as.Nil(result, "result isn't nil")
+	}
+}
+
+func Test_Treap_Delete_func(t *testing.T) {
+	as := assert.New(t)
+	tp := NewTreapSeed(1)
+
+	for num := 0; num < 100; num++ {
+		tp.Insert(It(num))
+	}
+
+	expected := []int{}
+	for num := 0; num < 100; num++ {
+		if num%3 != 0 {
+			expected = append(expected, num)
+			continue
+		}
+
+		item, deleted := tp.Delete(It(num))
+		as.True(deleted, "item %d wasn't deleted", num)
+		as.Equal(item.(IntItem).value, num, "item deleted is incorrect")
+	}
+
+	checkTreap(t, &tp, expected)
+
+	item, deleted := tp.Delete(It(0))
+	as.False(deleted, "item deleted twice")
+	as.Nil(item, "item deleted isn't nil")
+}
+
+func Test_Treap_Length_func(t *testing.T) {
+	tp := NewTreapSeed(1)
+
+	for i := 1; i <= 10; i++ {
+		tp.Insert(It(i))
+		assert.Equalf(t, tp.Length(), i, "treap length doesn't match")
+	}
+}
+
+func Test_Treap_Clear_func(t *testing.T) {
+	tp := NewTreapSeed(1)
+
+	for i := 1; i <= 10; i++ {
+		tp.Insert(It(i))
+	}
+
+	tp.Clear()
+	assert.Nil(t, tp.root, "treap is empty, but root isn't nil")
+	assert.Equal(t, tp.Length(), 0, "treap is empty, but length isn't 0")
+}
+
+func Test_Treap_Split_func(t *testing.T) {
+	for key := -1; key <= 51; key++ {
+		tp := NewTreapSeed(int64(key))
+		for num := 0; num < 50; num++ {
+			tp.Insert(It(num))
+		}
+
+		pos := key
+		if pos < 0 {
+			pos = 0
+		} else if pos > 50 {
+			pos = 50
+		}
+
+		left, right := tp.Split(It(key))
+		checkTreap(t, &tp, []int{})
+		checkTreap(t, left, intRange(0, pos))
+		checkTreap(t, right, intRange(pos, 50))
+	}
+}
+
+func Test_Treap_Merge_func(t *testing.T) {
+	as := assert.New(t)
+	newTreap := func(min, max int, duplicated bool) *Treap {
+		tp := NewTreapSeed(int64(min))
+		tp.duplicated = duplicated
+
+		for num := min; num < max; num++ {
+			tp.Insert(It(num))
+		}
+
+		return &tp
+	}
+
+	for size := 0; size <= 30; size++ {
+		tp, other := newTreap(0, size, false), newTreap(size, 30, false)
+		as.True(tp.Merge(other), "treaps weren't merged")
+		checkTreap(t, tp, intRange(0, 30))
+		checkTreap(t, other, []int{})
+
+		tp, other = newTreap(size, 30, false), newTreap(0, size, false)
+		as.True(tp.Merge(other), "treaps weren't merged")
+		checkTreap(t, tp, intRange(0, 30))
+		checkTreap(t, other, []int{})
+	}
+
+	tp, other := newTreap(0, 10, false), newTreap(5, 15, false)
+	as.False(tp.Merge(other), "treaps were merged")
+	checkTreap(t, tp, intRange(0, 10))
+	checkTreap(t, other, intRange(5, 15))
+
+	other = newTreap(9, 15, false)
+	as.False(tp.Merge(other), "treaps were merged with duplicated items")
+
+	tp, other = newTreap(0, 10, true), newTreap(9, 12, true)
+	as.True(tp.Merge(other), "treaps weren't merged")
+	checkTreap(t, tp, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 9, 10, 11})
+
+	tp, other = newTreap(0, 10, false), newTreap(10, 15, true)
+	as.False(tp.Merge(other), "treap with duplicated items was merged")
+	as.False(tp.Merge(tp), "treap was merged with itself")
+}
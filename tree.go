@@ -1,6 +1,6 @@
 package mygostructs
 
-import "sync"
+import "sort"
 
 // max returns the param more large
 func max(a, b int) int {
@@ -15,6 +15,7 @@ func max(a, b int) int {
 type treeNode struct {
 	ltree, rtree *treeNode
 	height       int
+	size         int // Number of nodes of the tree whose root is this node.
 	item         Item
 }
 
@@ -27,6 +28,20 @@ func (node *treeNode) getHeight() int {
 	return -1
 }
 
+// getSize returns the `node.size` property. If node is nil, then returns 0
+func (node *treeNode) getSize() int {
+	if node != nil {
+		return node.size
+	}
+
+	return 0
+}
+
+// updateSize calculates the `node.size` property using the size of its children.
+func (node *treeNode) updateSize() {
+	node.size = node.ltree.getSize() + node.rtree.getSize() + 1
+}
+
 // maxHeights returns the max value of left tree height and right tree height
 func (node treeNode) maxHeight() int {
 	return max(node.ltree.getHeight(), node.rtree.getHeight())
@@ -41,6 +56,9 @@ func (node *treeNode) rotateRight() *treeNode {
 	newNode.height = newNode.maxHeight() + 1
 	node.height = node.maxHeight() + 1
 
+	node.updateSize()
+	newNode.updateSize()
+
 	return newNode
 }
 
@@ -53,6 +71,9 @@ func (node *treeNode) rotateLeft() *treeNode {
 	newNode.height = newNode.maxHeight() + 1
 	node.height = node.maxHeight() + 1
 
+	node.updateSize()
+	newNode.updateSize()
+
 	return newNode
 }
 
@@ -69,76 +90,160 @@ func (node *treeNode) rotateLeftRight() *treeNode {
 }
 
 // Tree struct is the base for the Bst struct and AVL struct.
+//
+// The tree uses a reader/writer lock: the methods that only read the tree (Search, Length, Min,
+// Range, Rank...) share the lock and run in parallel, and the methods that modify it wait until
+// the reads finish.
 type Tree struct {
 	root       *treeNode  // Tree root.
 	length     int        // Number of tree nodes.
 	rebalance  bool       // Rebalance the tree after modify it.
 	duplicated bool       // Flag indicating if allows duplicated items.
-	mutex      sync.Mutex // Lock for avoid the concurrence when manipulate the struct.
+	compare    Comparator // Function for comparing the items. If nil, Less and Eq are used.
+	capacity   int        // Max number of items. If it is 0, the number isn't limited.
+	mutex      rwLock     // Lock for avoid the concurrence when manipulate the struct.
 }
 
-// insertItem searchs the correct position inside of the param tree node, inserts the
-// it item and rebalance the node, if rebalance flag is true. If duplicated paramater is false, the
-// item inserted must be unique. The function returns the node rebalanced and a flag indicating it
-// item was added. The item isn't added if the item isn't unique and duplicated flag is false.
-func insertItem(node *treeNode, it Item, rebalanceIt bool, duplicated bool) (*treeNode, bool) {
-	var inserted bool
+// newTree creates an empty tree with the settings of the parameter.
+func newTree(rebalance bool, o options) Tree {
+	return Tree{
+		rebalance:  rebalance,
+		duplicated: o.duplicated,
+		compare:    o.compare,
+		capacity:   o.capacity,
+		mutex:      o.lock(),
+	}
+}
 
-	if node == nil {
-		return &treeNode{nil, nil, 0, it}, true
+// newSliceTree creates a perfectly balanced tree with the items of the slice and the settings of
+// the parameter. The items are prepared as in the sliceNodes function.
+func newSliceTree(items []Item, rebalance bool, o options) Tree {
+	root, length := sliceNodes(items, o.duplicated, o.compare, o.capacity)
+	return Tree{
+		root:       root,
+		length:     length,
+		rebalance:  rebalance,
+		duplicated: o.duplicated,
+		compare:    o.compare,
+		capacity:   o.capacity,
+		mutex:      o.lock(),
 	}
+}
 
-	if node.item.Eq(it) && !duplicated {
-		return node, false
+// emptyCopy returns an empty tree with the settings of the tr tree.
+func (tr *Tree) emptyCopy() Tree {
+	return Tree{
+		rebalance:  tr.rebalance,
+		duplicated: tr.duplicated,
+		compare:    tr.compare,
+		capacity:   tr.capacity,
+		mutex:      rwLock{disabled: tr.mutex.disabled},
 	}
+}
+
+// pathStep is a step in the path from the root of a tree to a node.
+type pathStep struct {
+	node  *treeNode // node visited.
+	right bool      // flag indicating if the path continues by the right child of the node.
+}
 
-	if it.Less(node.item) {
-		node.ltree, inserted = insertItem(node.ltree, it, rebalanceIt, duplicated)
-	} else {
-		node.rtree, inserted = insertItem(node.rtree, it, rebalanceIt, duplicated)
+// pathSize is the initial capacity of the paths. It is enough for the balanced trees, so the
+// path doesn't need to grow.
+const pathSize = 64
+
+// fixPath links the nodes of the path again, from the bottom to the top, after a node was inserted
+// or deleted below of the last node of the path. The node param is the new child of the last node
+// of the path. The size of the nodes in the path is changed in delta units and the nodes are
+// rebalanced if the rebalance flag is true. Returns the new root of the tree.
+func fixPath(path []pathStep, node *treeNode, delta int, rebalanceIt bool) *treeNode {
+	for i := len(path) - 1; i >= 0; i-- {
+		parent := path[i].node
+
+		if path[i].right {
+			parent.rtree = node
+		} else {
+			parent.ltree = node
+		}
+
+		parent.size += delta
+		if rebalanceIt {
+			parent = rebalance(parent)
+		}
+
+		node = parent
 	}
 
-	if inserted && rebalanceIt {
-		node = rebalance(node)
+	return node
+}
+
+// insertItem searchs the correct position inside of the param tree node, inserts the
+// it item and rebalance the node, if reb flag is true. If duplicated paramater is false, the
+// item inserted must be unique. The function returns the node rebalanced and a flag indicating it
+// item was added. The item isn't added if the item isn't unique and duplicated flag is false. The
+// items are compared with the cmp comparator.
+func insertItem(node *treeNode, it Item, reb, duplicated bool, cmp Comparator) (*treeNode, bool) {
+	var buf [pathSize]pathStep
+
+	path := buf[:0]
+
+	bc := cmp.bind(it)
+	for current := node; current != nil; {
+		c := bc.compare(current.item)
+		if !duplicated && c == 0 {
+			return node, false
+		}
+
+		right := c >= 0
+		path = append(path, pathStep{current, right})
+
+		if right {
+			current = current.rtree
+		} else {
+			current = current.ltree
+		}
 	}
 
-	return node, inserted
+	return fixPath(path, &treeNode{nil, nil, 0, 1, it}, 1, reb), true
 }
 
 // insertGetAdy searchs the position in the node, inserts the item and rebalance the node if reb
 // flag is true. If duplicated paramater is false, the item inserted must be unique. The function
 // returns the node rebalanced, the item previous already inserted in the tree, and a flag
 // indicating it item was added. The item isn't added if the item isn't unique and duplicated flag
-// is false.
-func insertGetAdy(node *treeNode, item Item, reb, duplicated bool) (*treeNode, *Item, bool) {
+// is false. The items are compared with the cmp comparator.
+func insertGetAdy(
+	node *treeNode, item Item, reb, duplicated bool, cmp Comparator,
+) (*treeNode, *Item, bool) {
 	var (
-		inserted bool
-		prev     *Item
+		buf  [pathSize]pathStep
+		prev *Item
 	)
 
-	if node == nil {
-		return &treeNode{nil, nil, 0, item}, nil, true
-	}
+	path := buf[:0]
 
-	if node.item.Eq(item) && !duplicated {
-		return node, nil, false
-	}
+	bc := cmp.bind(item)
+	for current := node; current != nil; {
+		c := bc.compare(current.item)
+		if !duplicated && c == 0 {
+			return node, prev, false
+		}
 
-	if item.Less(node.item) {
-		node.ltree, prev, inserted = insertGetAdy(node.ltree, item, reb, duplicated)
-	} else {
-		node.rtree, prev, inserted = insertGetAdy(node.rtree, item, reb, duplicated)
-	}
+		// the deepest node whose item is less than or equal to the item is the previous.
+		right := c >= 0
+		if right {
+			prev = &current.item
+		}
 
-	if prev == nil && (node.item.Less(item) || node.item.Eq(item)) {
-		prev = &node.item
-	}
+		path = append(path, pathStep{current, right})
 
-	if inserted && reb {
-		node = rebalance(node)
+		if right {
+			current = current.rtree
+		} else {
+			current = current.ltree
+		}
 	}
 
-	return node, prev, inserted
+	return fixPath(path, &treeNode{nil, nil, 0, 1, item}, 1, reb), prev, true
 }
 
 // rebalance rebalances the node and return it.
@@ -146,7 +251,7 @@ func rebalance(node *treeNode) *treeNode {
 	if node.ltree.getHeight()-node.rtree.getHeight() == 2 {
 		ltree := node.ltree
 
-		if ltree.ltree.getHeight() <= ltree.rtree.getHeight() {
+		if ltree.ltree.getHeight() < ltree.rtree.getHeight() {
 			node = node.rotateLeftRight()
 		} else {
 			node = node.rotateRight()
@@ -155,7 +260,7 @@ func rebalance(node *treeNode) *treeNode {
 	} else if node.rtree.getHeight()-node.ltree.getHeight() == 2 {
 		rtree := node.rtree
 
-		if rtree.rtree.getHeight() <= rtree.ltree.getHeight() {
+		if rtree.rtree.getHeight() < rtree.ltree.getHeight() {
 			node = node.rotateRightLeft()
 		} else {
 			node = node.rotateLeft()
@@ -167,14 +272,18 @@ func rebalance(node *treeNode) *treeNode {
 }
 
 // Insert inserts the item in the tree. The function returns a flag indicating if the operation
-// was success or the item cannot be inserted because it was duplicated.
+// was success or the item cannot be inserted because it was duplicated or the tree is full.
 func (tr *Tree) Insert(it Item) bool {
 	var inserted bool
 
 	tr.mutex.Lock()
 	defer tr.mutex.Unlock()
 
-	tr.root, inserted = insertItem(tr.root, it, tr.rebalance, tr.duplicated)
+	if isFull(tr.length, tr.capacity) {
+		return false
+	}
+
+	tr.root, inserted = insertItem(tr.root, it, tr.rebalance, tr.duplicated, tr.compare)
 
 	if inserted {
 		tr.length++
@@ -185,38 +294,41 @@ func (tr *Tree) Insert(it Item) bool {
 
 // Length returns the number of items in the tree.
 func (tr *Tree) Length() int {
-	tr.mutex.Lock()
-	defer tr.mutex.Unlock()
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
 
 	return tr.length
 }
 
-// search searchs the item in the node tree. Returns the treeNode that contains the item or nil
-// if the item isn't found. Also returns a flag indicating if the item exists.
-func search(node *treeNode, it Item) (*treeNode, bool) {
-	if node == nil {
-		// item  not found
-		return nil, false
-	}
-
-	if node.item.Eq(it) {
-		return node, true
-	}
+// search searchs the item in the node tree, comparing the items with the cmp comparator. Returns
+// the treeNode that contains the item or nil if the item isn't found. Also returns a flag
+// indicating if the item exists.
+func search(node *treeNode, it Item, cmp Comparator) (*treeNode, bool) {
+	bc := cmp.bind(it)
+	for node != nil {
+		c := bc.compare(node.item)
+		if c == 0 {
+			return node, true
+		}
 
-	if node.item.Less(it) {
-		return search(node.rtree, it)
+		if c > 0 {
+			node = node.rtree
+		} else {
+			node = node.ltree
+		}
 	}
 
-	return search(node.ltree, it)
+	// item  not found
+	return nil, false
 }
 
 // Search searchs the item in the tree. It returns the item found and a flag indicating if
 // the item exists in the tree tree.
 func (tr *Tree) Search(it Item) (Item, bool) {
-	tr.mutex.Lock()
-	defer tr.mutex.Unlock()
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
 
-	if node, found := search(tr.root, it); found {
+	if node, found := search(tr.root, it, tr.compare); found {
 		return node.item, true
 	}
 
@@ -224,46 +336,60 @@ func (tr *Tree) Search(it Item) (Item, bool) {
 }
 
 // deleteNode searchs the item in the node tree, it deletes it and rebalance the tree, if the flag
-// is true. The functions returns the node rebalanced, the item deleted and a flag indicating if
-// the item existed in the tree.
-func deleteNode(node *treeNode, it Item, rebalanceIt bool) (*treeNode, Item, bool) {
+// is true. The items are compared with the cmp comparator. The functions returns the node
+// rebalanced, the item deleted and a flag indicating if the item existed in the tree.
+func deleteNode(node *treeNode, it Item, rebalanceIt bool, cmp Comparator) (*treeNode, Item, bool) {
 	var (
-		found     bool
+		buf       [pathSize]pathStep
 		itDeleted Item
+		found     bool
 	)
 
-	if node == nil {
-		return node, nil, false
-	}
+	path := buf[:0]
+	current := node
+	bc := cmp.bind(it)
+	for current != nil {
+		if c := bc.compare(current.item); c != 0 {
+			right := c > 0
+			path = append(path, pathStep{current, right})
+
+			if right {
+				current = current.rtree
+			} else {
+				current = current.ltree
+			}
+			continue
+		}
 
-	if node.item.Eq(it) {
-		if node.ltree == nil {
-			return node.rtree, node.item, true
-		} else if node.rtree == nil {
-			return node.ltree, node.item, true
+		if !found {
+			itDeleted = current.item
+			found = true
 		}
 
-		nodeTemp := node.rtree
-		for nodeTemp.ltree != nil {
-			nodeTemp = nodeTemp.ltree
+		if current.ltree == nil || current.rtree == nil {
+			break
 		}
 
-		itDeleted = node.item
-		node.item = nodeTemp.item
-		node.rtree, _, _ = deleteNode(node.rtree, nodeTemp.item, rebalanceIt)
-		found = true
+		// The node has two children. Its item is replaced by the next item, and the node
+		// of the next item is deleted from the right subtree.
+		nodeTemp := minNode(current.rtree)
+		current.item = nodeTemp.item
+		bc = cmp.bind(nodeTemp.item)
 
-	} else if node.item.Less(it) {
-		node.rtree, itDeleted, found = deleteNode(node.rtree, it, rebalanceIt)
-	} else {
-		node.ltree, itDeleted, found = deleteNode(node.ltree, it, rebalanceIt)
+		path = append(path, pathStep{current, true})
+		current = current.rtree
 	}
 
-	if found && rebalanceIt {
-		node = rebalance(node)
+	if !found {
+		return node, nil, false
 	}
 
-	return node, itDeleted, found
+	child := current.ltree
+	if child == nil {
+		child = current.rtree
+	}
+
+	return fixPath(path, child, -1, rebalanceIt), itDeleted, true
 }
 
 // Delete deletes the item of the tree. Returns the item deleted and a flag indicating if the item
@@ -272,7 +398,7 @@ func (tr *Tree) Delete(it Item) (itd Item, deleted bool) {
 	tr.mutex.Lock()
 	defer tr.mutex.Unlock()
 
-	tr.root, itd, deleted = deleteNode(tr.root, it, tr.rebalance)
+	tr.root, itd, deleted = deleteNode(tr.root, it, tr.rebalance, tr.compare)
 	if deleted {
 		tr.length--
 	}
@@ -287,3 +413,667 @@ func (tr *Tree) Clear() {
 	tr.root = nil
 	tr.length = 0
 }
+
+// TraversalOrder is the order used to visit the items of a tree.
+type TraversalOrder int
+
+const (
+	// InOrder visits the left subtree, the node and the right subtree. The items are visited
+	// sorted.
+	InOrder TraversalOrder = iota
+
+	// PreOrder visits the node, the left subtree and the right subtree.
+	PreOrder
+
+	// PostOrder visits the left subtree, the right subtree and the node.
+	PostOrder
+
+	// LevelOrder visits the nodes level by level, starting from the root.
+	LevelOrder
+)
+
+// children returns the children of the node in the visit order. If reverse flag is true, the
+// right child is returned first.
+func (node *treeNode) children(reverse bool) (*treeNode, *treeNode) {
+	if reverse {
+		return node.rtree, node.ltree
+	}
+
+	return node.ltree, node.rtree
+}
+
+// walk visits the nodes of the root tree in the order of the param and executes the visit
+// function with each node. If reverse flag is true, the right subtree is visited before the left
+// subtree. The walk stops when the visit function returns false. The function returns false if
+// the walk was stopped.
+func walk(root *treeNode, order TraversalOrder, reverse bool, visit func(*treeNode) bool) bool {
+	var stack []*treeNode
+
+	if root == nil {
+		return true
+	}
+
+	switch order {
+	case InOrder:
+		node := root
+		for node != nil || len(stack) > 0 {
+			if node != nil {
+				stack = append(stack, node)
+				node, _ = node.children(reverse)
+				continue
+			}
+
+			node = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if !visit(node) {
+				return false
+			}
+
+			_, node = node.children(reverse)
+		}
+
+	case PreOrder:
+		stack = append(stack, root)
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if !visit(node) {
+				return false
+			}
+
+			first, second := node.children(reverse)
+			if second != nil {
+				stack = append(stack, second)
+			}
+
+			if first != nil {
+				stack = append(stack, first)
+			}
+		}
+
+	case PostOrder:
+		var last *treeNode
+
+		node := root
+		for node != nil || len(stack) > 0 {
+			if node != nil {
+				stack = append(stack, node)
+				node, _ = node.children(reverse)
+				continue
+			}
+
+			top := stack[len(stack)-1]
+			if _, second := top.children(reverse); second != nil && second != last {
+				node = second
+				continue
+			}
+
+			if !visit(top) {
+				return false
+			}
+
+			last = top
+			stack = stack[:len(stack)-1]
+		}
+
+	case LevelOrder:
+		queue := []*treeNode{root}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+
+			if !visit(node) {
+				return false
+			}
+
+			first, second := node.children(reverse)
+			if first != nil {
+				queue = append(queue, first)
+			}
+
+			if second != nil {
+				queue = append(queue, second)
+			}
+		}
+	}
+
+	return true
+}
+
+// traverse executes the f function with the items of the tree in the order of the param. The
+// mutex is unlocked while the f function is running.
+func (tr *Tree) traverse(order TraversalOrder, reverse bool, f func(Item) bool) {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	walk(tr.root, order, reverse, func(node *treeNode) bool {
+		it := node.item
+
+		tr.mutex.RUnlock()
+		defer tr.mutex.RLock()
+
+		return f(it)
+	})
+}
+
+// Traverse executes the function of the parameter with the items of the tree, in the order of
+// the parameter. The traverse stops when the function returns false. The tree is unlocked while
+// the function is running, so it can use the tree methods, but the behaviour of this function
+// isn't defined if you modify the tree inside of the function or in another thread while this
+// method is executing.
+func (tr *Tree) Traverse(order TraversalOrder, f func(Item) bool) {
+	tr.traverse(order, false, f)
+}
+
+// TraverseReverse works as the Traverse function, but it visits the right subtree of each node
+// before the left subtree. Using the InOrder order, the items are visited from the greatest to the
+// smallest.
+func (tr *Tree) TraverseReverse(order TraversalOrder, f func(Item) bool) {
+	tr.traverse(order, true, f)
+}
+
+// minNode returns the node with the smallest item of the node tree, or nil if the node is nil.
+func minNode(node *treeNode) *treeNode {
+	for node != nil && node.ltree != nil {
+		node = node.ltree
+	}
+
+	return node
+}
+
+// maxNode returns the node with the greatest item of the node tree, or nil if the node is nil.
+func maxNode(node *treeNode) *treeNode {
+	for node != nil && node.rtree != nil {
+		node = node.rtree
+	}
+
+	return node
+}
+
+// floorNode returns the node with the greatest item less than or equal to the it item, or nil if
+// it doesn't exist. The items are compared with the cmp comparator.
+func floorNode(node *treeNode, it Item, cmp Comparator) *treeNode {
+	var floor *treeNode
+
+	bc := cmp.bind(it)
+	for node != nil {
+		c := bc.compare(node.item)
+		if c == 0 {
+			return node
+		}
+
+		if c < 0 {
+			node = node.ltree
+		} else {
+			floor = node
+			node = node.rtree
+		}
+	}
+
+	return floor
+}
+
+// ceilingNode returns the node with the smallest item greater than or equal to the it item, or
+// nil if it doesn't exist. The items are compared with the cmp comparator.
+func ceilingNode(node *treeNode, it Item, cmp Comparator) *treeNode {
+	var ceiling *treeNode
+
+	bc := cmp.bind(it)
+	for node != nil {
+		c := bc.compare(node.item)
+		if c == 0 {
+			return node
+		}
+
+		if c > 0 {
+			node = node.rtree
+		} else {
+			ceiling = node
+			node = node.ltree
+		}
+	}
+
+	return ceiling
+}
+
+// predecessorNode returns the node with the greatest item strictly less than the it item, or nil
+// if it doesn't exist. The items are compared with the cmp comparator.
+func predecessorNode(node *treeNode, it Item, cmp Comparator) *treeNode {
+	var pred *treeNode
+
+	for node != nil {
+		if cmp.less(node.item, it) {
+			pred = node
+			node = node.rtree
+		} else {
+			node = node.ltree
+		}
+	}
+
+	return pred
+}
+
+// successorNode returns the node with the smallest item strictly greater than the it item, or nil
+// if it doesn't exist. The items are compared with the cmp comparator.
+func successorNode(node *treeNode, it Item, cmp Comparator) *treeNode {
+	var succ *treeNode
+
+	for node != nil {
+		if cmp.less(it, node.item) {
+			succ = node
+			node = node.ltree
+		} else {
+			node = node.rtree
+		}
+	}
+
+	return succ
+}
+
+// nodeItem returns the item of the node and a flag indicating if the node exists.
+func nodeItem(node *treeNode) (Item, bool) {
+	if node == nil {
+		return nil, false
+	}
+
+	return node.item, true
+}
+
+// Min returns the smallest item of the tree. The second value returned is false if the tree is
+// empty.
+func (tr *Tree) Min() (Item, bool) {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	return nodeItem(minNode(tr.root))
+}
+
+// Max returns the greatest item of the tree. The second value returned is false if the tree is
+// empty.
+func (tr *Tree) Max() (Item, bool) {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	return nodeItem(maxNode(tr.root))
+}
+
+// Floor returns the greatest item of the tree less than or equal to the item of the parameter.
+// The second value returned is a flag indicating if the item exists.
+func (tr *Tree) Floor(it Item) (Item, bool) {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	return nodeItem(floorNode(tr.root, it, tr.compare))
+}
+
+// Ceiling returns the smallest item of the tree greater than or equal to the item of the
+// parameter. The second value returned is a flag indicating if the item exists.
+func (tr *Tree) Ceiling(it Item) (Item, bool) {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	return nodeItem(ceilingNode(tr.root, it, tr.compare))
+}
+
+// Predecessor returns the greatest item of the tree strictly less than the item of the
+// parameter. The item of the parameter doesn't need to be in the tree. The second value returned
+// is a flag indicating if the item exists.
+func (tr *Tree) Predecessor(it Item) (Item, bool) {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	return nodeItem(predecessorNode(tr.root, it, tr.compare))
+}
+
+// Successor returns the smallest item of the tree strictly greater than the item of the
+// parameter. The item of the parameter doesn't need to be in the tree. The second value returned
+// is a flag indicating if the item exists.
+func (tr *Tree) Successor(it Item) (Item, bool) {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	return nodeItem(successorNode(tr.root, it, tr.compare))
+}
+
+// Bounds defines the range of items used in the range functions of the tree. If From or To is
+// nil, the range doesn't have lower or upper limit.
+type Bounds struct {
+	From, To         Item // Limits of the range.
+	FromIncl, ToIncl bool // Flags indicating if the limits are inside of the range.
+}
+
+// afterFrom checks if the it item is greater than the lower limit of the range, comparing the
+// items with the cmp comparator.
+func (b Bounds) afterFrom(it Item, cmp Comparator) bool {
+	if b.From == nil {
+		return true
+	}
+
+	if b.FromIncl {
+		return !cmp.less(it, b.From)
+	}
+
+	return cmp.less(b.From, it)
+}
+
+// beforeTo checks if the it item is less than the upper limit of the range, comparing the items
+// with the cmp comparator.
+func (b Bounds) beforeTo(it Item, cmp Comparator) bool {
+	if b.To == nil {
+		return true
+	}
+
+	if b.ToIncl {
+		return !cmp.less(b.To, it)
+	}
+
+	return cmp.less(it, b.To)
+}
+
+// walkRange visits sorted the nodes of the node tree whose items are inside of the b range and
+// executes the visit function with each node. The items are compared with the cmp comparator. The
+// walk stops when the visit function returns false. The function returns false if the walk was
+// stopped.
+func walkRange(node *treeNode, b Bounds, cmp Comparator, visit func(*treeNode) bool) bool {
+	var stack []*treeNode
+
+	for node != nil || len(stack) > 0 {
+		if node != nil {
+			if b.afterFrom(node.item, cmp) {
+				stack = append(stack, node)
+				node = node.ltree
+			} else {
+				// the node and its left subtree are before of the range.
+				node = node.rtree
+			}
+			continue
+		}
+
+		node = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if !b.beforeTo(node.item, cmp) {
+			// the rest of nodes are after of the range.
+			return true
+		}
+
+		if !visit(node) {
+			return false
+		}
+
+		node = node.rtree
+	}
+
+	return true
+}
+
+// joinNodes joins the left tree, the mid node and the right tree in a new tree and returns it.
+// All items of the left tree must be less than or equal to the mid item and all items of the
+// right tree must be greater than or equal to the mid item. If the rebalance flag is true, the
+// trees must be AVL trees and the new tree is rebalanced.
+func joinNodes(left, mid, right *treeNode, rebalanceIt bool) *treeNode {
+	if !rebalanceIt {
+		mid.ltree, mid.rtree = left, right
+		mid.updateSize()
+		return mid
+	}
+
+	if left.getHeight() > right.getHeight()+1 {
+		left.rtree = joinNodes(left.rtree, mid, right, rebalanceIt)
+		left.updateSize()
+		return rebalance(left)
+	}
+
+	if right.getHeight() > left.getHeight()+1 {
+		right.ltree = joinNodes(left, mid, right.ltree, rebalanceIt)
+		right.updateSize()
+		return rebalance(right)
+	}
+
+	mid.ltree, mid.rtree = left, right
+	mid.height = mid.maxHeight() + 1
+	mid.updateSize()
+	return mid
+}
+
+// deleteMin deletes the node with the smallest item of the node tree. It returns the node tree
+// without the smallest node and the smallest node. The node param cannot be nil.
+func deleteMin(node *treeNode, rebalanceIt bool) (*treeNode, *treeNode) {
+	var buf [pathSize]pathStep
+
+	path := buf[:0]
+	min := node
+	for min.ltree != nil {
+		path = append(path, pathStep{min, false})
+		min = min.ltree
+	}
+
+	return fixPath(path, min.rtree, -1, rebalanceIt), min
+}
+
+// concatNodes joins the left tree and the right tree in a new tree and returns it. All items of
+// the left tree must be less than or equal to the items of the right tree.
+func concatNodes(left, right *treeNode, rebalanceIt bool) *treeNode {
+	var mid *treeNode
+
+	if left == nil {
+		return right
+	}
+
+	if right == nil {
+		return left
+	}
+
+	right, mid = deleteMin(right, rebalanceIt)
+	return joinNodes(left, mid, right, rebalanceIt)
+}
+
+// splitNodes splits the node tree in two trees. The first tree contains the items where the
+// toLeft function returns true and the second tree contains the rest of items. The toLeft
+// function must return true for all items less than an item where it returns true.
+func splitNodes(node *treeNode, toLeft func(Item) bool, rebalanceIt bool) (*treeNode, *treeNode) {
+	var left, right *treeNode
+
+	if node == nil {
+		return nil, nil
+	}
+
+	if toLeft(node.item) {
+		left, right = splitNodes(node.rtree, toLeft, rebalanceIt)
+		return joinNodes(node.ltree, node, left, rebalanceIt), right
+	}
+
+	left, right = splitNodes(node.ltree, toLeft, rebalanceIt)
+	return left, joinNodes(right, node, node.rtree, rebalanceIt)
+}
+
+// Range executes the function of the parameter with the items of the tree that are inside of the
+// b range, sorted. The iteration stops when the function returns false. As in the Traverse
+// function, the tree is unlocked while the function is running and the behaviour of this function
+// isn't defined if you modify the tree inside of the function or in another thread while this
+// method is executing.
+func (tr *Tree) Range(b Bounds, f func(Item) bool) {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	walkRange(tr.root, b, tr.compare, func(node *treeNode) bool {
+		it := node.item
+
+		tr.mutex.RUnlock()
+		defer tr.mutex.RLock()
+
+		return f(it)
+	})
+}
+
+// CountRange returns the number of items of the tree that are inside of the b range.
+func (tr *Tree) CountRange(b Bounds) int {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	count := countPrefix(tr.root, func(it Item) bool {
+		return b.beforeTo(it, tr.compare)
+	}) - countPrefix(tr.root, func(it Item) bool {
+		return !b.afterFrom(it, tr.compare)
+	})
+
+	return max(count, 0)
+}
+
+// DeleteRange deletes all items of the tree that are inside of the b range, in only one
+// operation. Returns the number of items deleted.
+func (tr *Tree) DeleteRange(b Bounds) int {
+	var left, mid, right *treeNode
+
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+
+	left, right = splitNodes(tr.root, func(it Item) bool {
+		return !b.afterFrom(it, tr.compare)
+	}, tr.rebalance)
+
+	mid, right = splitNodes(right, func(it Item) bool {
+		return b.beforeTo(it, tr.compare)
+	}, tr.rebalance)
+	count := mid.getSize()
+
+	tr.root = concatNodes(left, right, tr.rebalance)
+	tr.length -= count
+	return count
+}
+
+// countPrefix returns the number of items of the node tree where the inPrefix function returns
+// true. The inPrefix function must return true for all items less than an item where it returns
+// true.
+func countPrefix(node *treeNode, inPrefix func(Item) bool) int {
+	count := 0
+
+	for node != nil {
+		if inPrefix(node.item) {
+			count += node.ltree.getSize() + 1
+			node = node.rtree
+		} else {
+			node = node.ltree
+		}
+	}
+
+	return count
+}
+
+// selectNode returns the node with the k-th smallest item of the node tree, starting from 0, or
+// nil if it doesn't exist.
+func selectNode(node *treeNode, k int) *treeNode {
+	for node != nil {
+		lsize := node.ltree.getSize()
+
+		switch {
+		case k < lsize:
+			node = node.ltree
+		case k > lsize:
+			k -= lsize + 1
+			node = node.rtree
+		default:
+			return node
+		}
+	}
+
+	return nil
+}
+
+// Rank returns the number of items of the tree less than the item of the parameter. The item of
+// the parameter doesn't need to be in the tree.
+func (tr *Tree) Rank(it Item) int {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	return countPrefix(tr.root, func(nodeItem Item) bool {
+		return tr.compare.less(nodeItem, it)
+	})
+}
+
+// Select returns the k-th smallest item of the tree. The k parameter starts from 0, so Select(0)
+// returns the smallest item. The second value returned is false if k is out of range.
+func (tr *Tree) Select(k int) (Item, bool) {
+	tr.mutex.RLock()
+	defer tr.mutex.RUnlock()
+
+	if k < 0 {
+		return nil, false
+	}
+
+	return nodeItem(selectNode(tr.root, k))
+}
+
+// sortedItems returns a slice with the items of the node tree, sorted.
+func sortedItems(node *treeNode) []Item {
+	items := make([]Item, 0, node.getSize())
+
+	walk(node, InOrder, false, func(node *treeNode) bool {
+		items = append(items, node.item)
+		return true
+	})
+
+	return items
+}
+
+// buildNodes builds a perfectly balanced tree with the items of the sorted slice and returns its
+// root.
+func buildNodes(items []Item) *treeNode {
+	if len(items) == 0 {
+		return nil
+	}
+
+	mid := len(items) / 2
+	node := &treeNode{item: items[mid]}
+	node.ltree = buildNodes(items[:mid])
+	node.rtree = buildNodes(items[mid+1:])
+	node.height = node.maxHeight() + 1
+	node.updateSize()
+
+	return node
+}
+
+// sliceNodes builds a perfectly balanced tree with the items of the slice, sorted with the cmp
+// comparator. If the duplicated flag is false, the duplicated items are discarded. If capacity
+// isn't 0 and the items don't fit in it, the greatest items are discarded. Returns the root of the
+// tree and its number of items.
+func sliceNodes(items []Item, duplicated bool, cmp Comparator, capacity int) (*treeNode, int) {
+	items = prepareItems(items, duplicated, cmp)
+	if capacity > 0 && len(items) > capacity {
+		items = items[:capacity]
+	}
+
+	return buildNodes(items), len(items)
+}
+
+// prepareItems returns a copy of the items slice, sorted with the cmp comparator. If the
+// duplicated flag is false, the duplicated items are removed, keeping the first of them. The slice
+// is only sorted if it isn't already sorted.
+func prepareItems(items []Item, duplicated bool, cmp Comparator) []Item {
+	sorted := make([]Item, len(items))
+	copy(sorted, items)
+
+	for i := 1; i < len(sorted); i++ {
+		if cmp.less(sorted[i], sorted[i-1]) {
+			sort.SliceStable(sorted, func(a, b int) bool {
+				return cmp.less(sorted[a], sorted[b])
+			})
+			break
+		}
+	}
+
+	if duplicated || len(sorted) == 0 {
+		return sorted
+	}
+
+	unique := sorted[:1]
+	for _, it := range sorted[1:] {
+		if !cmp.eq(it, unique[len(unique)-1]) {
+			unique = append(unique, it)
+		}
+	}
+
+	return unique
+}
@@ -3,6 +3,8 @@ package mygostructs
 import (
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
 	"testing"
 	"time"
 )
@@ -166,22 +168,22 @@ func Test_treeNode_getHeight_func(t *testing.T) {
 	as := assert.New(t)
 
 	as.Equal((*treeNode)(nil).getHeight(), -1, "when node is nil, must returns -1")
-	as.Equal((&treeNode{nil, nil, 1, nil}).getHeight(), 1, "node height doesn't match")
+	as.Equal((&treeNode{nil, nil, 1, 1, nil}).getHeight(), 1, "node height doesn't match")
 }
 
 func Test_treeNode_maxHeight_func(t *testing.T) {
 	as := assert.New(t)
 
-	node := treeNode{nil, nil, 3, nil}
+	node := treeNode{nil, nil, 3, 1, nil}
 	as.Equal(node.maxHeight(), -1, "children are nil, must returns -1")
 
-	node = treeNode{&treeNode{nil, nil, 10, nil}, nil, 3, nil}
+	node = treeNode{&treeNode{nil, nil, 10, 1, nil}, nil, 3, 2, nil}
 	as.Equal(node.maxHeight(), 10, "value doesn't match with the height of left child")
 
-	node = treeNode{nil, &treeNode{nil, nil, 10, nil}, 3, nil}
+	node = treeNode{nil, &treeNode{nil, nil, 10, 1, nil}, 3, 2, nil}
 	as.Equal(node.maxHeight(), 10, "value doesn't match with the height of right child")
 
-	node = treeNode{&treeNode{nil, nil, 10, nil}, &treeNode{nil, nil, 11, nil}, 3, nil}
+	node = treeNode{&treeNode{nil, nil, 10, 1, nil}, &treeNode{nil, nil, 11, 1, nil}, 3, 3, nil}
 	as.Equal(
 		node.maxHeight(),
 		11,
@@ -190,11 +192,11 @@ func Test_treeNode_maxHeight_func(t *testing.T) {
 }
 
 func Test_treeNode_rotateRight_func(t *testing.T) {
-	tree1 := &treeNode{nil, nil, 0, It(1)}
-	tree2 := &treeNode{nil, nil, 0, It(2)}
-	tree3 := &treeNode{nil, nil, 0, It(3)}
-	tree4 := &treeNode{nil, nil, 0, It(4)}
-	tree5 := &treeNode{nil, nil, 0, It(5)}
+	tree1 := &treeNode{nil, nil, 0, 1, It(1)}
+	tree2 := &treeNode{nil, nil, 0, 1, It(2)}
+	tree3 := &treeNode{nil, nil, 0, 1, It(3)}
+	tree4 := &treeNode{nil, nil, 0, 1, It(4)}
+	tree5 := &treeNode{nil, nil, 0, 1, It(5)}
 
 	tree2.ltree = tree1
 	tree2.rtree = tree3
@@ -226,11 +228,11 @@ func Test_treeNode_rotateRight_func(t *testing.T) {
 }
 
 func Test_treeNode_rotateLeft_func(t *testing.T) {
-	tree1 := &treeNode{nil, nil, 0, It(1)}
-	tree2 := &treeNode{nil, nil, 0, It(2)}
-	tree3 := &treeNode{nil, nil, 0, It(3)}
-	tree4 := &treeNode{nil, nil, 0, It(4)}
-	tree5 := &treeNode{nil, nil, 0, It(5)}
+	tree1 := &treeNode{nil, nil, 0, 1, It(1)}
+	tree2 := &treeNode{nil, nil, 0, 1, It(2)}
+	tree3 := &treeNode{nil, nil, 0, 1, It(3)}
+	tree4 := &treeNode{nil, nil, 0, 1, It(4)}
+	tree5 := &treeNode{nil, nil, 0, 1, It(5)}
 
 	tree4.ltree = tree3
 	tree4.rtree = tree5
@@ -260,11 +262,11 @@ func Test_treeNode_rotateLeft_func(t *testing.T) {
 }
 
 func Test_treeNode_rotateRightLeft_func(t *testing.T) {
-	tree1 := &treeNode{nil, nil, 0, It(1)}
-	tree2 := &treeNode{nil, nil, 0, It(2)}
-	tree3 := &treeNode{nil, nil, 0, It(3)}
-	tree4 := &treeNode{nil, nil, 0, It(4)}
-	tree5 := &treeNode{nil, nil, 0, It(5)}
+	tree1 := &treeNode{nil, nil, 0, 1, It(1)}
+	tree2 := &treeNode{nil, nil, 0, 1, It(2)}
+	tree3 := &treeNode{nil, nil, 0, 1, It(3)}
+	tree4 := &treeNode{nil, nil, 0, 1, It(4)}
+	tree5 := &treeNode{nil, nil, 0, 1, It(5)}
 
 	tree4.ltree = tree3
 	tree4.rtree = tree5
@@ -294,11 +296,11 @@ func Test_treeNode_rotateRightLeft_func(t *testing.T) {
 }
 
 func Test_treeNode_rotateLeftRight_func(t *testing.T) {
-	tree1 := &treeNode{nil, nil, 0, It(1)}
-	tree2 := &treeNode{nil, nil, 0, It(2)}
-	tree3 := &treeNode{nil, nil, 0, It(3)}
-	tree4 := &treeNode{nil, nil, 0, It(4)}
-	tree5 := &treeNode{nil, nil, 0, It(5)}
+	tree1 := &treeNode{nil, nil, 0, 1, It(1)}
+	tree2 := &treeNode{nil, nil, 0, 1, It(2)}
+	tree3 := &treeNode{nil, nil, 0, 1, It(3)}
+	tree4 := &treeNode{nil, nil, 0, 1, It(4)}
+	tree5 := &treeNode{nil, nil, 0, 1, It(5)}
 
 	tree2.ltree = tree1
 	tree2.rtree = tree3
@@ -347,7 +349,7 @@ func Test_insertGetAdy_func(t *testing.T) {
 	prevItems = []*int{nil, i(3), nil, i(4), i(4), i(4), i(4), nil, nil, i(7)}
 	for indx, num := range items {
 		item := It(num)
-		root, prev, inserted = insertGetAdy(root, item, true, false)
+		root, prev, inserted = insertGetAdy(root, item, true, false, nil)
 		as.True(inserted, "item %s wasn't inserted", item)
 
 		if prevItems[indx] == nil {
@@ -387,7 +389,7 @@ func Test_insertGetAdy_func(t *testing.T) {
 	})
 
 	// insert item duplicated.
-	root, prev, inserted = insertGetAdy(root, It(1), true, false)
+	root, prev, inserted = insertGetAdy(root, It(1), true, false, nil)
 	as.False(inserted, "duplicated item was inserted")
 	as.Nil(prev, "previous item isn't nil when item wasn't inserted")
 
@@ -414,7 +416,7 @@ func Test_insertGetAdy_func(t *testing.T) {
 	prevItems = []*int{nil, i(4), nil, nil, i(4), i(4), i(8), nil, nil, i(4)}
 	for indx, num := range items {
 		item := It(num)
-		root, prev, inserted = insertGetAdy(root, item, false, false)
+		root, prev, inserted = insertGetAdy(root, item, false, false, nil)
 		as.True(inserted, "the item %s wasn't inserted", item)
 
 		if prevItems[indx] == nil {
@@ -453,7 +455,7 @@ func Test_insertGetAdy_func(t *testing.T) {
 	})
 
 	// insert item duplicated.
-	root, prev, inserted = insertGetAdy(root, It(1), false, false)
+	root, prev, inserted = insertGetAdy(root, It(1), false, false, nil)
 	as.False(inserted, "duplicated item was inserted")
 	as.Nil(prev, "previous item isn't nil when item wasn't inserted")
 
@@ -467,7 +469,7 @@ func Test_insertGetAdy_func(t *testing.T) {
 	prevItems = []*int{nil, nil, i(3), nil, nil, nil, i(4), i(4), i(1), i(2)}
 	for indx, num := range items {
 		item := It(num)
-		root, prev, inserted = insertGetAdy(root, item, true, true)
+		root, prev, inserted = insertGetAdy(root, item, true, true, nil)
 		as.True(inserted, "the item %s wasn't inserted", item)
 
 		if prevItems[indx] == nil {
@@ -521,7 +523,7 @@ func Test_insertGetAdy_func(t *testing.T) {
 	prevItems = []*int{nil, nil, i(3), nil, nil, nil, i(4), i(4), i(1), i(2)}
 	for indx, num := range items {
 		item := It(num)
-		root, prev, inserted = insertGetAdy(root, item, false, true)
+		root, prev, inserted = insertGetAdy(root, item, false, true, nil)
 		as.True(inserted, "the item %s wasn't inserted", item)
 
 		if prevItems[indx] == nil {
@@ -922,14 +924,14 @@ func Test_Tree_Delete_func(t *testing.T) {
 		{0, 0, nil, nil},
 		{1, 1, i(0), i(2)},
 		{2, 0, nil, nil},
-		{3, 3, i(1), i(6)},
+		{3, 3, i(1), i(5)},
 		{4, 0, nil, nil},
-		{5, 1, i(4), nil},
-		{6, 2, i(5), i(7)},
-		{7, 0, nil, nil},
+		{5, 2, i(4), i(7)},
+		{6, 0, nil, nil},
+		{7, 1, i(6), nil},
 	})
 
-	// delete one node it has only the left child.
+	// delete one node it has 2 children, the successor replaces it.
 	item, deleted = tree.Delete(It(5))
 	as.Equal(item.(IntItem).value, 5, "item deleted is incorrect")
 	as.True(deleted, "item wasn't deleted")
@@ -1097,18 +1099,18 @@ func Test_Tree_Delete_func(t *testing.T) {
 	as.Equal(item.(IntItem).value, 0, "item deleted is incorrect")
 	as.True(deleted, "item wasn't deleted")
 	checkTree(t, tree.root, []treeTest{
+		{1, 1, nil, i(1)},
 		{1, 0, nil, nil},
-		{1, 2, i(1), i(2)},
-		{2, 1, nil, i(2)},
+		{2, 2, i(1), i(2)},
 		{2, 0, nil, nil},
-		{3, 3, i(1), i(4)},
+		{3, 3, i(2), i(4)},
 		{3, 0, nil, nil},
 		{4, 2, i(3), i(5)},
 		{4, 0, nil, nil},
 		{5, 1, i(4), nil},
 	})
 
-	// delete node with right child.
+	// delete node with 2 children, the successor replaces it.
 	item, deleted = tree.Delete(It(2))
 	as.Equal(item.(IntItem).value, 2, "item deleted is incorrect")
 	as.True(deleted, "item wasn't deleted")
@@ -1278,3 +1280,598 @@ func Test_Tree_Clear_func_sync(t *testing.T) {
 	assert.Nil(t, tree.root, "tree root isn't nil")
 	assert.Equal(t, tree.length, 0, "tree length isn't 0")
 }
+
+// traverseValues returns the values of the tree items visited by the traverse function.
+func traverseValues(traverse func(TraversalOrder, func(Item) bool), order TraversalOrder) []int {
+	values := []int{}
+
+	traverse(order, func(it Item) bool {
+		values = append(values, it.(IntItem).value)
+		return true
+	})
+
+	return values
+}
+
+func Test_Tree_Traverse_func(t *testing.T) {
+	as := assert.New(t)
+	tree := Tree{rebalance: true}
+
+	tree.Traverse(InOrder, func(Item) bool {
+		as.FailNow("function was executed when the tree was empty")
+		return true
+	})
+
+	for i := 0; i < 10; i++ {
+		tree.Insert(It(i))
+	}
+
+	/*
+		Tree:
+		        3
+		    1       7
+		  0   2   5   8
+		         4 6    9
+	*/
+	results := map[TraversalOrder][]int{
+		InOrder:    {0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		PreOrder:   {3, 1, 0, 2, 7, 5, 4, 6, 8, 9},
+		PostOrder:  {0, 2, 1, 4, 6, 5, 9, 8, 7, 3},
+		LevelOrder: {3, 1, 7, 0, 2, 5, 8, 4, 6, 9},
+	}
+
+	for order, result := range results {
+		as.Equal(traverseValues(tree.Traverse, order), result, "order %d is invalid", order)
+	}
+
+	// stop the traverse
+	for order := range results {
+		count := 0
+		tree.Traverse(order, func(Item) bool {
+			count++
+			return count < 4
+		})
+		as.Equal(count, 4, "the traverse in order %d didn't stop", order)
+	}
+}
+
+func Test_Tree_TraverseReverse_func(t *testing.T) {
+	as := assert.New(t)
+	tree := Tree{rebalance: true}
+
+	for i := 0; i < 10; i++ {
+		tree.Insert(It(i))
+	}
+
+	results := map[TraversalOrder][]int{
+		InOrder:    {9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+		PreOrder:   {3, 7, 8, 9, 5, 6, 4, 1, 2, 0},
+		PostOrder:  {9, 8, 6, 4, 5, 7, 2, 0, 1, 3},
+		LevelOrder: {3, 7, 1, 8, 5, 2, 0, 9, 6, 4},
+	}
+
+	for order, result := range results {
+		values := traverseValues(tree.TraverseReverse, order)
+		as.Equal(values, result, "order %d is invalid", order)
+	}
+}
+
+func Test_Tree_Traverse_func_sync(t *testing.T) {
+	as := assert.New(t)
+	tree := Tree{rebalance: true}
+	concurrence := 8
+	size := 1000
+	done := make(chan bool)
+
+	traverse := func() {
+		i := 0
+		tree.Traverse(InOrder, func(it Item) bool {
+			as.Equal(it.(IntItem).value, i, "value is incorrect")
+
+			// the tree can be used inside of the function.
+			_, found := tree.Search(it)
+			as.True(found, "item %s not found", it)
+
+			i++
+			return true
+		})
+
+		as.Equal(i, size, "number of items visited doesn't match")
+		done <- true
+	}
+
+	for i := 0; i < size; i++ {
+		tree.Insert(It(i))
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go traverse()
+		go treeChangeProp(&tree, size, done)
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+		<-done
+	}
+}
+
+// checkNavigation checks the item returned by a tree navigation function.
+func checkNavigation(t *testing.T, it Item, found bool, value *int, msg string) {
+	if value == nil {
+		assert.False(t, found, "%s: item found", msg)
+		assert.Nil(t, it, "%s: item isn't nil", msg)
+		return
+	}
+
+	if assert.True(t, found, "%s: item not found", msg) {
+		assert.Equal(t, it.(IntItem).value, *value, "%s: item doesn't match", msg)
+	}
+}
+
+func Test_Tree_Min_Max_func(t *testing.T) {
+	tree := Tree{rebalance: true}
+
+	it, found := tree.Min()
+	checkNavigation(t, it, found, nil, "min in empty tree")
+	it, found = tree.Max()
+	checkNavigation(t, it, found, nil, "max in empty tree")
+
+	for _, num := range []int{5, 3, 8, 1, 4, 9, 7} {
+		tree.Insert(It(num))
+	}
+
+	it, found = tree.Min()
+	checkNavigation(t, it, found, i(1), "min")
+	it, found = tree.Max()
+	checkNavigation(t, it, found, i(9), "max")
+}
+
+func Test_Tree_Floor_Ceiling_func(t *testing.T) {
+	tree := Tree{rebalance: true}
+
+	// tree with the even numbers from 0 to 18.
+	for num := 0; num < 10; num++ {
+		tree.Insert(It(num * 2))
+	}
+
+	results := []struct {
+		item           int
+		floor, ceiling *int
+	}{
+		{-1, nil, i(0)},
+		{0, i(0), i(0)},
+		{5, i(4), i(6)},
+		{10, i(10), i(10)},
+		{17, i(16), i(18)},
+		{18, i(18), i(18)},
+		{19, i(18), nil},
+	}
+
+	for _, result := range results {
+		it, found := tree.Floor(It(result.item))
+		checkNavigation(t, it, found, result.floor, fmt.Sprintf("floor of %d", result.item))
+
+		it, found = tree.Ceiling(It(result.item))
+		msg := fmt.Sprintf("ceiling of %d", result.item)
+		checkNavigation(t, it, found, result.ceiling, msg)
+	}
+}
+
+func Test_Tree_Predecessor_Successor_func(t *testing.T) {
+	tree := Tree{rebalance: false, duplicated: true}
+
+	for _, num := range []int{6, 2, 10, 0, 4, 8, 12, 4} {
+		tree.Insert(It(num))
+	}
+
+	results := []struct {
+		item       int
+		pred, succ *int
+	}{
+		{-1, nil, i(0)},
+		{0, nil, i(2)},
+		{3, i(2), i(4)},
+		{4, i(2), i(6)},
+		{6, i(4), i(8)},
+		{12, i(10), nil},
+		{13, i(12), nil},
+	}
+
+	for _, result := range results {
+		it, found := tree.Predecessor(It(result.item))
+		msg := fmt.Sprintf("predecessor of %d", result.item)
+		checkNavigation(t, it, found, result.pred, msg)
+
+		it, found = tree.Successor(It(result.item))
+		msg = fmt.Sprintf("successor of %d", result.item)
+		checkNavigation(t, it, found, result.succ, msg)
+	}
+}
+
+// treeValues returns the values of the tree items, sorted.
+func treeValues(tree *Tree) []int {
+	return traverseValues(tree.Traverse, InOrder)
+}
+
+// checkHeights checks the height of all nodes of the node tree. Returns the node height.
+func checkHeights(t *testing.T, node *treeNode) int {
+	if node == nil {
+		return -1
+	}
+
+	height := max(checkHeights(t, node.ltree), checkHeights(t, node.rtree)) + 1
+	assert.Equal(t, node.height, height, "in node %s: the height doesn't match", node.item)
+	return height
+}
+
+// checkBalance checks the heights of the node tree and that the heights of the subtrees of each
+// node differ in one at most. Returns the height of the node.
+func checkBalance(t *testing.T, node *treeNode) int {
+	if node == nil {
+		return -1
+	}
+
+	lh, rh := checkBalance(t, node.ltree), checkBalance(t, node.rtree)
+	msg := fmt.Sprintf("in node %s", node.item)
+	assert.Equal(t, node.height, node.maxHeight()+1, "%s: the height doesn't match", msg)
+	assert.LessOrEqual(t, lh-rh, 1, "node %s isn't balanced", node.item)
+	assert.GreaterOrEqual(t, lh-rh, -1, "node %s isn't balanced", node.item)
+	return node.height
+}
+
+func Test_Tree_balance_func_random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	// random deletes.
+	tree := Tree{rebalance: true}
+	for i := 0; i < 2000; i++ {
+		tree.Insert(It(rnd.Intn(1000)))
+		if i%3 == 0 {
+			tree.Delete(It(rnd.Intn(1000)))
+		}
+	}
+
+	for i := 0; i < 1000; i++ {
+		tree.Delete(It(rnd.Intn(1000)))
+	}
+
+	checkBalance(t, tree.root)
+
+	// split and join cycles.
+	avlTree := NewAvlFromSlice(itemRange(0, 1000))
+	avl := &avlTree
+	for i := 0; i < 200; i++ {
+		left, right := avl.Split(It(rnd.Intn(1000)))
+		left.Join(right)
+		left.Delete(It(rnd.Intn(1000)))
+		avl = left
+	}
+
+	checkBalance(t, avl.root)
+	avl.DeleteRange(Bounds{It(100), It(700), true, false})
+	checkBalance(t, avl.root)
+
+	// persistent tree deletes.
+	pa := NewPersistentAvlFromSlice(itemRange(0, 1000))
+	for i := 0; i < 1000; i++ {
+		pa, _, _ = pa.Delete(It(rnd.Intn(1000)))
+	}
+
+	checkBalance(t, pa.root)
+
+	// interval tree deletes.
+	itr := NewIntervalTree()
+	for i := 0; i < 1000; i++ {
+		itr.Insert(NewInterval(It(i), It(i+rnd.Intn(10))))
+	}
+
+	for i := 0; i < 1000; i++ {
+		low := rnd.Intn(1000)
+		itr.Delete(NewInterval(It(low), It(low+rnd.Intn(10))))
+	}
+
+	checkBalance(t, itr.root)
+}
+
+func Test_Tree_Range_func(t *testing.T) {
+	as := assert.New(t)
+	tree := Tree{rebalance: true, duplicated: true}
+
+	for _, num := range []int{5, 1, 3, 7, 9, 3, 5, 2, 8} {
+		tree.Insert(It(num))
+	}
+
+	results := []struct {
+		bounds Bounds
+		values []int
+	}{
+		{Bounds{}, []int{1, 2, 3, 3, 5, 5, 7, 8, 9}},
+		{Bounds{It(3), It(7), true, true}, []int{3, 3, 5, 5, 7}},
+		{Bounds{It(3), It(7), false, true}, []int{5, 5, 7}},
+		{Bounds{It(3), It(7), true, false}, []int{3, 3, 5, 5}},
+		{Bounds{It(3), It(7), false, false}, []int{5, 5}},
+		{Bounds{nil, It(3), false, false}, []int{1, 2}},
+		{Bounds{It(6), nil, false, false}, []int{7, 8, 9}},
+		{Bounds{It(10), nil, true, false}, []int{}},
+		{Bounds{It(7), It(3), true, true}, []int{}},
+	}
+
+	for _, result := range results {
+		values := []int{}
+		tree.Range(result.bounds, func(it Item) bool {
+			values = append(values, it.(IntItem).value)
+			return true
+		})
+
+		as.Equal(values, result.values, "range %v is invalid", result.bounds)
+		as.Equal(
+			tree.CountRange(result.bounds),
+			len(result.values),
+			"count of range %v is invalid",
+			result.bounds,
+		)
+	}
+
+	// stop the iteration
+	count := 0
+	tree.Range(Bounds{}, func(Item) bool {
+		count++
+		return count < 3
+	})
+	as.Equal(count, 3, "the iteration didn't stop")
+}
+
+func Test_Tree_DeleteRange_func(t *testing.T) {
+	as := assert.New(t)
+	params := []struct {
+		rebalance, duplicated bool
+	}{
+		{false, false},
+		{false, true},
+		{true, false},
+		{true, true},
+	}
+
+	for _, param := range params {
+		for from := 0; from < 18; from++ {
+			for to := from; to < 18; to++ {
+				tree := Tree{rebalance: param.rebalance}
+				tree.duplicated = param.duplicated
+				expected := []int{}
+				length := 0
+
+				// insert the numbers from 0 to 15 twice.
+				for num := 0; num < 32; num++ {
+					value := num * 7 % 16
+					if !tree.Insert(It(value)) {
+						continue
+					}
+
+					length++
+					if value < from || value >= to {
+						expected = append(expected, value)
+					}
+				}
+
+				sort.Ints(expected)
+				bounds := Bounds{It(from), It(to), true, false}
+				msg := fmt.Sprintf("params: %+v, range: [%d, %d)", param, from, to)
+
+				deleted := tree.DeleteRange(bounds)
+				as.Equal(deleted, length-len(expected), "%s: invalid deleted", msg)
+				as.Equal(treeValues(&tree), expected, "%s: items are invalid", msg)
+				as.Equal(tree.Length(), len(expected), "%s: length is invalid", msg)
+
+				if param.rebalance {
+					checkHeights(t, tree.root)
+				}
+			}
+		}
+	}
+}
+
+func Test_Tree_DeleteRange_func_sync(t *testing.T) {
+	as := assert.New(t)
+	tree := Tree{rebalance: true}
+	concurrence := 8
+	size := 1000
+	done := make(chan bool)
+
+	deleteRange := func(min, max int) {
+		for i := min; i < max; i += 10 {
+			bounds := Bounds{It(i), It(i + 10), true, false}
+			as.Equal(tree.DeleteRange(bounds), 10, "number of items deleted is invalid")
+		}
+
+		done <- true
+	}
+
+	for i := 0; i < size*concurrence; i++ {
+		tree.Insert(It(i))
+	}
+
+	for i := 0; i < concurrence; i++ {
+		go deleteRange(i*size, (i+1)*size)
+		go treeChangeProp(&tree, size, done)
+	}
+
+	for i := 0; i < concurrence; i++ {
+		<-done
+		<-done
+	}
+
+	as.Equal(tree.Length(), 0, "the tree isn't empty")
+	as.Nil(tree.root, "tree root isn't nil")
+}
+
+// checkSizes checks the size of all nodes of the node tree. Returns the node size.
+func checkSizes(t *testing.T, node *treeNode) int {
+	if node == nil {
+		return 0
+	}
+
+	size := checkSizes(t, node.ltree) + checkSizes(t, node.rtree) + 1
+	assert.Equal(t, node.size, size, "in node %s: the size doesn't match", node.item)
+	return size
+}
+
+func Test_treeNode_size(t *testing.T) {
+	as := assert.New(t)
+	params := []struct {
+		rebalance, duplicated bool
+	}{
+		{false, false},
+		{false, true},
+		{true, false},
+		{true, true},
+	}
+
+	for _, param := range params {
+		tree := Tree{rebalance: param.rebalance, duplicated: param.duplicated}
+
+		for num := 0; num < 100; num++ {
+			tree.Insert(It(num * 37 % 50))
+			as.Equal(checkSizes(t, tree.root), tree.length, "size of root is invalid")
+		}
+
+		for num := 0; num < 50; num++ {
+			tree.Delete(It(num * 13 % 50))
+			as.Equal(checkSizes(t, tree.root), tree.length, "size of root is invalid")
+		}
+
+		tree.DeleteRange(Bounds{From: It(10), To: It(20)})
+		as.Equal(checkSizes(t, tree.root), tree.length, "size of root is invalid")
+	}
+
+	// insertGetAdy function
+	var root *treeNode
+	for num := 0; num < 100; num++ {
+		root, _, _ = insertGetAdy(root, It(num*37%50), true, true, nil)
+	}
+	as.Equal(checkSizes(t, root), 100, "size of root is invalid")
+}
+
+func Test_Tree_Rank_func(t *testing.T) {
+	as := assert.New(t)
+	tree := Tree{rebalance: true, duplicated: true}
+
+	as.Equal(tree.Rank(It(5)), 0, "rank in empty tree isn't 0")
+
+	for _, num := range []int{4, 8, 2, 6, 0, 4, 10} {
+		tree.Insert(It(num))
+	}
+
+	results := map[int]int{-1: 0, 0: 0, 1: 1, 2: 1, 4: 2, 5: 4, 6: 4, 10: 6, 11: 7}
+	for num, rank := range results {
+		as.Equal(tree.Rank(It(num)), rank, "rank of %d is invalid", num)
+	}
+}
+
+func Test_Tree_Select_func(t *testing.T) {
+	tree := Tree{rebalance: true, duplicated: true}
+
+	it, found := tree.Select(0)
+	checkNavigation(t, it, found, nil, "select in empty tree")
+
+	for _, num := range []int{4, 8, 2, 6, 0, 4, 10} {
+		tree.Insert(It(num))
+	}
+
+	for k, num := range []int{0, 2, 4, 4, 6, 8, 10} {
+		it, found := tree.Select(k)
+		checkNavigation(t, it, found, i(num), fmt.Sprintf("select %d", k))
+	}
+
+	it, found = tree.Select(-1)
+	checkNavigation(t, it, found, nil, "select -1")
+	it, found = tree.Select(7)
+	checkNavigation(t, it, found, nil, "select 7")
+}
+
+func Test_prepareItems_func(t *testing.T) {
+	as := assert.New(t)
+
+	values := func(items []Item) []int {
+		result := []int{}
+		for _, it := range items {
+			result = append(result, it.(IntItem).value)
+		}
+		return result
+	}
+
+	items := []Item{It(3), It(1), It(2), It(1), It(3)}
+	as.Equal(values(prepareItems(items, false, nil)), []int{1, 2, 3}, "unique items are wrong")
+	as.Equal(values(prepareItems(items, true, nil)), []int{1, 1, 2, 3, 3}, "items are invalid")
+	as.Equal(values(items), []int{3, 1, 2, 1, 3}, "the slice of the parameter changed")
+	as.Equal(values(prepareItems(nil, false, nil)), []int{}, "empty slice is invalid")
+}
+
+func Test_buildNodes_func(t *testing.T) {
+	items := []Item{}
+	for num := 0; num < 10; num++ {
+		items = append(items, It(num))
+	}
+
+	checkTree(t, buildNodes(items), []treeTest{
+		{0, 0, nil, nil},
+		{1, 1, i(0), nil},
+		{2, 2, i(1), i(4)},
+		{3, 0, nil, nil},
+		{4, 1, i(3), nil},
+		{5, 3, i(2), i(8)},
+		{6, 0, nil, nil},
+		{7, 1, i(6), nil},
+		{8, 2, i(7), i(9)},
+		{9, 0, nil, nil},
+	})
+
+	checkSizes(t, buildNodes(items))
+	assert.Nil(t, buildNodes(nil), "root of empty tree isn't nil")
+}
+
+// checkSharedRead checks that the read function doesn't wait while the mutex is locked for reading
+// by another thread.
+func checkSharedRead(t *testing.T, mutex *rwLock, name string, read func()) {
+	done := make(chan bool)
+
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	go func() {
+		read()
+		done <- true
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("%s waits for the read lock", name)
+		<-done
+	}
+}
+
+func Test_Tree_reads_shared(t *testing.T) {
+	tree := Tree{rebalance: true}
+	for num := 0; num < 10; num++ {
+		tree.Insert(It(num))
+	}
+
+	reads := map[string]func(){
+		"Search":     func() { tree.Search(It(5)) },
+		"Length":     func() { tree.Length() },
+		"Min":        func() { tree.Min() },
+		"Max":        func() { tree.Max() },
+		"Floor":      func() { tree.Floor(It(5)) },
+		"Ceiling":    func() { tree.Ceiling(It(5)) },
+		"Rank":       func() { tree.Rank(It(5)) },
+		"Select":     func() { tree.Select(5) },
+		"CountRange": func() { tree.CountRange(Bounds{}) },
+		"Traverse": func() {
+			tree.Traverse(InOrder, func(it Item) bool { return true })
+		},
+	}
+
+	for name, read := range reads {
+		checkSharedRead(t, &tree.mutex, name, read)
+	}
+}
@@ -16,6 +16,14 @@ type Item interface {
 	String() string
 }
 
+// Comparer is an optional interface of the items. The structs compare the items that implement it
+// with only one call to the Compare function, instead of calling the Less and Eq functions.
+type Comparer interface {
+	// Compare returns a negative number if the item is less than the item of the parameter, 0
+	// if they are equal and a positive number if the item is greater.
+	Compare(Item) int
+}
+
 // IntItem structs is an implementation of the Item interface specific for storing int numbers.
 type IntItem struct {
 	value int // number stored
@@ -35,6 +43,22 @@ func (iit IntItem) Eq(it Item) bool {
 	return valid && iit.value == iitp.value
 }
 
+// Compare compares the iit item with the item of the parameter. It returns -1, 0 or 1 if iit is
+// less than, equal to or greater than the item of the parameter. The function returns 1 if the
+// parameter isn't type IntItem, as Less and Eq return false.
+func (iit IntItem) Compare(it Item) int {
+	iitp, valid := it.(IntItem)
+
+	switch {
+	case !valid || iit.value > iitp.value:
+		return 1
+	case iit.value < iitp.value:
+		return -1
+	}
+
+	return 0
+}
+
 // String returns the number as string.
 func (iit IntItem) String() string {
 	return fmt.Sprintf("%d", iit.value)
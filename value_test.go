@@ -3,6 +3,7 @@ package mygostructs
 import (
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"math"
 	"testing"
 )
 
@@ -54,6 +55,45 @@ func Test_IntItem_Eq_func(t *testing.T) {
 	assert.False(t, (It(1)).Eq(testItem{1}))
 }
 
+func Test_IntItem_Compare_func(t *testing.T) {
+	for i := -100; i <= 100; i++ {
+		assert.Negative(t, It(i).Compare(It(i+1)))
+		assert.Zero(t, It(i).Compare(It(i)))
+		assert.Positive(t, It(i).Compare(It(i-1)))
+	}
+
+	// The values of opposite sign don't overflow.
+	assert.Equal(t, It(math.MinInt).Compare(It(math.MaxInt)), -1)
+	assert.Equal(t, It(math.MaxInt).Compare(It(math.MinInt)), 1)
+	assert.Equal(t, It(math.MaxInt).Compare(It(-1)), 1)
+	assert.Equal(t, It(-1).Compare(It(math.MaxInt)), -1)
+	assert.Equal(t, It(math.MinInt).Compare(It(1)), -1)
+
+	// The parameter of the Compare function isn't type IntItem
+	assert.Positive(t, (It(1)).Compare(testItem{1}))
+}
+
+func Test_IntItem_Compare_func_extremes(t *testing.T) {
+	avl := NewAvl()
+	for _, num := range []int{math.MaxInt, -1, math.MinInt, 0} {
+		avl.Insert(It(num))
+	}
+
+	min, _ := avl.Min()
+	max, _ := avl.Max()
+	assert.Equal(t, min, It(math.MinInt), "min is incorrect")
+	assert.Equal(t, max, It(math.MaxInt), "max is incorrect")
+
+	items := []Item{}
+	avl.Traverse(InOrder, func(it Item) bool {
+		items = append(items, it)
+		return true
+	})
+
+	assert.Equal(t, items, []Item{It(math.MinInt), It(-1), It(0), It(math.MaxInt)},
+		"items aren't sorted")
+}
+
 func Test_IntItem_String_func(t *testing.T) {
 	for i := -100; i <= 100; i++ {
 		assert.Equal(t, (It(i)).String(), fmt.Sprintf("%d", i))